@@ -3,6 +3,7 @@
 package booktest
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"time"
 )
@@ -26,18 +27,36 @@ func (e *BooksBookType) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e BooksBookType) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of BooksBookType.
+func (e BooksBookType) Valid() bool {
+	switch e {
+	case BooksBookTypeFICTION, BooksBookTypeNONFICTION:
+		return true
+	}
+	return false
+}
+
 type Author struct {
 	AuthorID int32
 	Name     string
 }
 
 type Book struct {
-	BookID    int32
-	AuthorID  int32
-	Isbn      string
-	BookType  BooksBookType
-	Title     string
-	Yr        int32
+	BookID   int32
+	AuthorID int32
+	// Default: ''
+	Isbn string
+	// Default: 'FICTION'
+	BookType BooksBookType
+	Title    string
+	// Default: 2000
+	Yr int32
+	// Default: CURRENT_TIMESTAMP()
 	Available time.Time
 	Tags      string
 }
@@ -3,6 +3,7 @@
 package booktest
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"time"
 )
@@ -26,18 +27,39 @@ func (e *BookType) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e BookType) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of BookType.
+func (e BookType) Valid() bool {
+	switch e {
+	case BookTypeFICTION, BookTypeNONFICTION:
+		return true
+	}
+	return false
+}
+
 type Author struct {
 	AuthorID int32
-	Name     string
+	// Default: ''
+	Name string
 }
 
 type Book struct {
-	BookID    int32
-	AuthorID  int32
-	Isbn      string
-	BookType  BookType
-	Title     string
-	Year      int32
+	BookID   int32
+	AuthorID int32
+	// Default: ''
+	Isbn string
+	// Default: 'FICTION'
+	BookType BookType
+	// Default: ''
+	Title string
+	// Default: 2000
+	Year int32
+	// Default: 'NOW()'
 	Available time.Time
-	Tags      []string
+	// Default: '{}'
+	Tags []string
 }
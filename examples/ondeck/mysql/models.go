@@ -4,6 +4,7 @@ package ondeck
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"time"
 )
@@ -27,6 +28,20 @@ func (e *VenuesStatus) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e VenuesStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of VenuesStatus.
+func (e VenuesStatus) Valid() bool {
+	switch e {
+	case VenuesStatusOpen, VenuesStatusClosed:
+		return true
+	}
+	return false
+}
+
 type City struct {
 	Slug string `json:"slug"`
 	Name string `json:"name"`
@@ -34,7 +49,7 @@ type City struct {
 
 // Venues are places where muisc happens
 type Venue struct {
-	ID int64 `json:"id"`
+	ID uint64 `json:"id"`
 	// Venues can be either open or closed
 	Status   VenuesStatus   `json:"status"`
 	Statuses sql.NullString `json:"statuses"`
@@ -45,5 +60,6 @@ type Venue struct {
 	SpotifyPlaylist string         `json:"spotify_playlist"`
 	SongkickID      sql.NullString `json:"songkick_id"`
 	Tags            sql.NullString `json:"tags"`
-	CreatedAt       time.Time      `json:"created_at"`
+	// Default: CURRENT_TIMESTAMP()
+	CreatedAt time.Time `json:"created_at"`
 }
@@ -4,6 +4,7 @@ package ondeck
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"time"
 )
@@ -28,6 +29,20 @@ func (e *Status) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e Status) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of Status.
+func (e Status) Valid() bool {
+	switch e {
+	case StatusOpen, StatusClosed:
+		return true
+	}
+	return false
+}
+
 type City struct {
 	Slug string `json:"slug"`
 	Name string `json:"name"`
@@ -45,5 +60,6 @@ type Venue struct {
 	SpotifyPlaylist string         `json:"spotify_playlist"`
 	SongkickID      sql.NullString `json:"songkick_id"`
 	Tags            []string       `json:"tags"`
-	CreatedAt       time.Time      `json:"created_at"`
+	// Default: NOW()
+	CreatedAt time.Time `json:"created_at"`
 }
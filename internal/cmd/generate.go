@@ -157,15 +157,15 @@ func Generate(e Env, dir, filename string, stderr io.Writer) (map[string]string,
 		}
 
 		result, failed := parse(e, name, dir, sql.SQL, combo, parseOpts, stderr)
+		if failed {
+			errored = true
+			break
+		}
 		for _, q := range result.Queries {
 			if q.Name == "InsertMulti" {
 				util.Xiazeminlog("parse result------", q, false)
 			}
 		}
-		if failed {
-			errored = true
-			break
-		}
 
 		var files map[string]string
 		var out string
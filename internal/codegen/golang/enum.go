@@ -20,6 +20,31 @@ type Enum struct {
 	Comment   string
 	IsNotNull bool
 	Constants []Constant
+	Null      string // constant standing for NULL in a nullable enum
+
+	// EmitNull is set when a query reads or writes a NOT NULL enum as
+	// nullable, which needs the Null<Name> wrapper type.
+	EmitNull bool
+}
+
+// Values returns the constants of the enum values, leaving out the constant
+// standing for NULL.
+func (e Enum) Values() []Constant {
+	var values []Constant
+	for _, c := range e.Constants {
+		if c.Name != e.Null {
+			values = append(values, c)
+		}
+	}
+	return values
+}
+
+// Set is the named []string type generated for a MySQL SET column.
+type Set struct {
+	Name      string
+	Comment   string
+	IsNotNull bool
+	Members   []string
 }
 
 var reHan = regexp.MustCompile("[\u4E00-\u9FFF]+")
@@ -12,6 +12,10 @@ type Field struct {
 	Tags    map[string]string
 	Comment string
 	IsSlice bool
+
+	// EmbedFields holds the fields of the model struct embedded by
+	// sqlc.embed(); the field itself has the model struct type.
+	EmbedFields []Field
 }
 
 func (gf Field) Tag() string {
@@ -150,19 +150,28 @@ import (
 type Querier interface {
 	{{- range .GoQueries}}
 	{{- if eq .Cmd ":one"}}
-	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ({{.Ret.Type}}, error)
+	{{.MethodName}}(ctx context.Context, {{.Args}}) ({{.Ret.Type}}, error)
 	{{- end}}
 	{{- if eq .Cmd ":many"}}
-	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.Type}}, error)
+	{{.MethodName}}(ctx context.Context, {{.Args}}) ([]{{.Ret.Type}}, error)
+	{{- end}}
+	{{- if eq .Cmd ":iter"}}
+	{{.MethodName}}(ctx context.Context, {{if .Args}}{{.Args}}, {{end}}fn func({{.Ret.Type}}) error) error
+	{{- end}}
+	{{- if eq .Cmd ":nested"}}
+	{{.MethodName}}(ctx context.Context, {{.Args}}) ([]{{.Ret.Type}}, error)
+	{{- end}}
+	{{- if eq .Cmd ":paginate"}}
+	{{.MethodName}}(ctx context.Context, {{if .Args}}{{.Args}}, {{end}}cursor string, pageSize int32) ([]{{.Ret.Type}}, string, error)
 	{{- end}}
 	{{- if eq .Cmd ":exec"}}
-	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) error
+	{{.MethodName}}(ctx context.Context, {{.Args}}) error
 	{{- end}}
 	{{- if eq .Cmd ":execrows"}}
-	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error)
+	{{.MethodName}}(ctx context.Context, {{.Args}}) (int64, error)
 	{{- end}}
 	{{- if eq .Cmd ":execresult"}}
-	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Result, error)
+	{{.MethodName}}(ctx context.Context, {{.Args}}) (sql.Result, error)
 	{{- end}}
 	{{- end}}
 }
@@ -210,6 +219,148 @@ func (e *{{.Name}}) Scan(src interface{}) error {
 	}
 	return nil
 }
+
+// Value implements the driver.Valuer interface.
+func (e {{.Name}}) Value() (driver.Value, error) {
+	{{- if .Null}}
+	if e == {{.Null}} {
+		return nil, nil
+	}
+	{{- end}}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of {{.Name}}.
+func (e {{.Name}}) Valid() bool {
+	{{- if .Values}}
+	switch e {
+	case {{range $i, $c := .Values}}{{if $i}}, {{end}}{{$c.Name}}{{end}}:
+		return true
+	}
+	{{- end}}
+	return false
+}
+{{if .EmitNull}}
+type Null{{.Name}} struct {
+	{{.Name}} {{.Name}}
+	Valid bool // Valid is true if {{.Name}} is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (ns *Null{{.Name}}) Scan(src interface{}) error {
+	if src == nil {
+		ns.{{.Name}}, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.{{.Name}}.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (ns Null{{.Name}}) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.{{.Name}}.Value()
+}
+{{end}}
+{{end}}
+
+{{range .Sets}}
+{{if .Comment}}{{comment .Comment}}{{end}}
+type {{.Name}} []string
+
+var {{lowerTitle .Name}}Members = map[string]bool{
+	{{- range .Members}}
+	{{printf "%q" .}}: true,
+	{{- end}}
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *{{.Name}}) Scan(src interface{}) error {
+	var str string
+	switch v := src.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	{{- if not .IsNotNull}}
+	case nil:
+		*s = nil
+		return nil
+	{{- end}}
+	default:
+		return fmt.Errorf("unsupported scan type for {{.Name}}: %T", src)
+	}
+	members := {{.Name}}{}
+	if str != "" {
+		for _, m := range strings.Split(str, ",") {
+			if !{{lowerTitle .Name}}Members[m] {
+				return fmt.Errorf("unknown {{.Name}} member: %q", m)
+			}
+			members = append(members, m)
+		}
+	}
+	*s = members
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (s {{.Name}}) Value() (driver.Value, error) {
+	{{- if not .IsNotNull}}
+	if s == nil {
+		return nil, nil
+	}
+	{{- end}}
+	for _, m := range s {
+		if !{{lowerTitle .Name}}Members[m] {
+			return nil, fmt.Errorf("unknown {{.Name}} member: %q", m)
+		}
+	}
+	return strings.Join(s, ","), nil
+}
+{{end}}
+
+{{range .NullUints}}
+// {{.Name}} represents a {{.Type}} that may be null.
+type {{.Name}} struct {
+	{{.Field}} {{.Type}}
+	Valid bool // Valid is true if {{.Field}} is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *{{.Name}}) Scan(src interface{}) error {
+	var v uint64
+	var err error
+	switch s := src.(type) {
+	case nil:
+		n.{{.Field}}, n.Valid = 0, false
+		return nil
+	case int64:
+		v = uint64(s)
+	case uint64:
+		v = s
+	case []byte:
+		v, err = strconv.ParseUint(string(s), 10, {{.Bits}})
+	case string:
+		v, err = strconv.ParseUint(s, 10, {{.Bits}})
+	default:
+		return fmt.Errorf("unsupported scan type for {{.Name}}: %T", src)
+	}
+	if err != nil {
+		return err
+	}
+	n.{{.Field}}, n.Valid = {{.Type}}(v), true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n {{.Name}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return {{.ValueType}}(n.{{.Field}}), nil
+}
 {{end}}
 
 {{range .Structs}}
@@ -265,6 +416,37 @@ type {{.Ret.Type}} struct { {{- range .Ret.Struct.Fields}}
 }
 {{end}}
 
+{{if .Paginate}}
+const {{.Paginate.ConstantName}} = {{$.Q}}-- name: {{.MethodName}} :paginate
+{{escape .Paginate.SQL}}
+{{$.Q}}
+
+type {{.Paginate.Cursor.Name}} struct { {{- range .Paginate.Cursor.Fields}}
+  {{.Name}} {{.Type}} {{$.Q}}{{.Tag}}{{$.Q}}
+  {{- end}}
+}
+{{end}}
+
+{{with .OrderBy}}
+// {{.Name}} is the ordering applied to {{$.Q}}{{.Arg}}{{$.Q}}.
+type {{.Name}} string
+
+const (
+	{{- range .Constants}}
+	{{.Name}} {{.Type}} = "{{.Value}}"
+	{{- end}}
+)
+
+// Valid reports whether e is one of the allowed orderings.
+func (e {{.Name}}) Valid() bool {
+	switch e {
+	case {{range $i, $c := .Constants}}{{if $i}}, {{end}}{{$c.Name}}{{end}}:
+		return true
+	}
+	return false
+}
+{{end}}
+
 {{if eq $ContainSlice false }}
 {{if eq .Arg.ContainSlice true }}
 {{ $ContainSlice = true }}
@@ -274,8 +456,17 @@ type {{.Ret.Type}} struct { {{- range .Ret.Struct.Fields}}
 {{if eq .Cmd ":one"}}
 {{range .Comments}}//{{.}}
 {{end -}}
-func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ({{.Ret.Type}}, error) {
-	{{if .Arg.EmitStruct}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Args}}) ({{.Ret.Type}}, error) {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		var {{.Ret.Name}} {{.Ret.Type}}
+		return {{.Ret.Name}}, fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
 	{{$ConstantName := .ConstantName}}
 	{{$argNmae := .Arg.Name}}
 	{{$ConstantName}}:={{$ConstantName}}
@@ -306,9 +497,9 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ({{.Ret.Ty
 	   {{.ConstantName}}:=replaceNth({{.ConstantName}}, "(?)", "( "+param+" )", 1)
 	{{end -}}
 	{{- if $.EmitPreparedQueries}}
-	row := q.queryRow(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	row := q.queryRow(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
 	{{- else}}
-	row := q.db.QueryRowContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	row := q.db.QueryRowContext(ctx, {{.Text}}, {{.Params}})
 	{{- end}}
 	var {{.Ret.Name}} {{.Ret.Type}}
 	err := row.Scan({{.Ret.Scan}})
@@ -319,8 +510,16 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ({{.Ret.Ty
 {{if eq .Cmd ":many"}}
 {{range .Comments}}//{{.}}
 {{end -}}
-func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.Type}}, error) {
-	{{if .Arg.EmitStruct}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Args}}) ([]{{.Ret.Type}}, error) {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		return nil, fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
 	{{$ConstantName := .ConstantName}}
 	{{$argNmae := .Arg.Name}}
 	{{$ConstantName}}:={{$ConstantName}}
@@ -351,9 +550,9 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.
 	
 	{{end -}}
 	{{- if $.EmitPreparedQueries}}
-	rows, err := q.query(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	rows, err := q.query(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
   	{{- else}}
-	rows, err := q.db.QueryContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	rows, err := q.db.QueryContext(ctx, {{.Text}}, {{.Params}})
   	{{- end}}
 	if err != nil {
 		return nil, err
@@ -381,11 +580,247 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.
 }
 {{end}}
 
+{{if eq .Cmd ":nested"}}
+{{range .Comments}}//{{.}}
+{{end -}}
+// {{.MethodName}} returns one result per parent row, holding the child rows
+// joined to it.
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Args}}) ([]{{.Ret.Type}}, error) {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		return nil, fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
+	{{$ConstantName := .ConstantName}}
+	{{$argNmae := .Arg.Name}}
+	{{$ConstantName}}:={{$ConstantName}}
+	{{- range .Arg.Struct.Fields}}
+	{{if eq .IsSlice true}}
+	if len({{$argNmae}}.{{.Name}}) <=0{
+		return nil,fmt.Errorf("{{$argNmae}}.{{.Name}} length is invalid")
+	}
+	{
+	param:="?"
+	for i:=0;i<len({{$argNmae}}.{{.Name}})-1;i++{
+	 param+=",?"   
+	}
+	{{$ConstantName}}=replaceNth({{$ConstantName}}, "(?)", "( "+param+" )", 1)
+    }
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	{{ if eq .Arg.IsSliceType true}}
+	if len({{.Arg.Name}})<=0 {
+		return nil,fmt.Errorf("{{.Arg.Name}} length is invalid")
+	}
+	   param:="?"
+	   for i:=0;i<len({{.Arg.Name}})-1;i++{
+		param+=",?"   
+	   }
+	   {{.ConstantName}}:=replaceNth({{.ConstantName}}, "(?)", "( "+param+" )", 1)
+	
+	{{end -}}
+	{{- if $.EmitPreparedQueries}}
+	rows, err := q.query(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
+  	{{- else}}
+	rows, err := q.db.QueryContext(ctx, {{.Text}}, {{.Params}})
+  	{{- end}}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	{{- if $.EmitEmptySlices}}
+	items := []{{.Ret.Type}}{}
+	{{else}}
+	var items []{{.Ret.Type}}
+	{{end -}}
+	index := map[{{.Nested.KeyType}}]int{}
+	for rows.Next() {
+		var {{.Ret.Name}} {{.Ret.Type}}
+		var child {{.Nested.Child}}
+		{{- if .Nested.Nulls.Fields}}
+		var nulls {{.Nested.NullsType}}
+		{{- end}}
+		if err := rows.Scan({{.Nested.Scan}}); err != nil {
+			return nil, err
+		}
+		key := {{.Nested.Key}}
+		n, ok := index[key]
+		if !ok {
+			{{.Ret.Name}}.{{.Nested.Field}} = []{{.Nested.Child}}{}
+			n = len(items)
+			index[key] = n
+			items = append(items, {{.Ret.Name}})
+		}
+		if {{.Nested.Present}} {
+			{{- range .Nested.Copy}}
+			{{.}}
+			{{- end}}
+			items[n].{{.Nested.Field}} = append(items[n].{{.Nested.Field}}, child)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+{{end}}
+
+{{if eq .Cmd ":iter"}}
+{{range .Comments}}//{{.}}
+{{end -}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{if .Args}}{{.Args}}, {{end}}fn func({{.Ret.Type}}) error) error {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		return fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
+	{{$ConstantName := .ConstantName}}
+	{{$argNmae := .Arg.Name}}
+	{{$ConstantName}}:={{$ConstantName}}
+	{{- range .Arg.Struct.Fields}}
+	{{if eq .IsSlice true}}
+	if len({{$argNmae}}.{{.Name}}) <=0{
+		return fmt.Errorf("{{$argNmae}}.{{.Name}} length is invalid")
+	}
+	{
+	param:="?"
+	for i:=0;i<len({{$argNmae}}.{{.Name}})-1;i++{
+	 param+=",?"   
+	}
+	{{$ConstantName}}=replaceNth({{$ConstantName}}, "(?)", "( "+param+" )", 1)
+    }
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	{{ if eq .Arg.IsSliceType true}}
+	if len({{.Arg.Name}})<=0 {
+		return fmt.Errorf("{{.Arg.Name}} length is invalid")
+	}
+	   param:="?"
+	   for i:=0;i<len({{.Arg.Name}})-1;i++{
+		param+=",?"   
+	   }
+	   {{.ConstantName}}:=replaceNth({{.ConstantName}}, "(?)", "( "+param+" )", 1)
+	
+	{{end -}}
+	{{- if $.EmitPreparedQueries}}
+	rows, err := q.query(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
+  	{{- else}}
+	rows, err := q.db.QueryContext(ctx, {{.Text}}, {{.Params}})
+  	{{- end}}
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var {{.Ret.Name}} {{.Ret.Type}}
+		if err := rows.Scan({{.Ret.Scan}}); err != nil {
+			return err
+		}
+		if err := fn({{.Ret.Name}}); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+{{end}}
+
+{{if eq .Cmd ":paginate"}}
+{{range .Comments}}//{{.}}
+{{end -}}
+// {{.MethodName}} returns at most pageSize rows following cursor, and the
+// cursor of the next page. The first page is returned for an empty cursor, and
+// the next cursor is empty after the last page.
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{if .Args}}{{.Args}}, {{end}}cursor string, pageSize int32) ([]{{.Ret.Type}}, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size: %d", pageSize)
+	}
+	query := {{.ConstantName}}
+	args := []interface{}{ {{.Arg.Params}} }
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		var key {{.Paginate.Cursor.Name}}
+		if err := json.Unmarshal(b, &key); err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		query = {{.Paginate.ConstantName}}
+		args = append(args, {{.Paginate.BindArgs}})
+	}
+	args = append(args, pageSize)
+	{{- if $.EmitPreparedQueries}}
+	rows, err := q.query(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
+  	{{- else}}
+	rows, err := q.db.QueryContext(ctx, {{.Text}}, {{.Params}})
+  	{{- end}}
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	{{- if $.EmitEmptySlices}}
+	items := []{{.Ret.Type}}{}
+	{{else}}
+	var items []{{.Ret.Type}}
+	{{end -}}
+	for rows.Next() {
+		var {{.Ret.Name}} {{.Ret.Type}}
+		if err := rows.Scan({{.Ret.Scan}}); err != nil {
+			return nil, "", err
+		}
+		items = append(items, {{.Ret.Name}})
+	}
+	if err := rows.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		b, err := json.Marshal({{.Paginate.Cursor.Name}}{ {{- .Paginate.CursorFields -}} })
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return items, next, nil
+}
+{{end}}
+
 {{if eq .Cmd ":exec"}}
 {{range .Comments}}//{{.}}
 {{end -}}
-func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) error {
-	{{if .Arg.EmitStruct}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Args}}) error {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		return fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
 	{{$ConstantName := .ConstantName}}
 	{{$argNmae := .Arg.Name}}
 	{{$ConstantName}}:={{$ConstantName}}
@@ -415,9 +850,9 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) error {
 	   {{.ConstantName}}:=replaceNth({{.ConstantName}}, "(?)", "( "+param+" )", 1)
 	{{end -}}
 	{{- if $.EmitPreparedQueries}}
-	_, err := q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	_, err := q.exec(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
   	{{- else}}
-	_, err := q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	_, err := q.db.ExecContext(ctx, {{.Text}}, {{.Params}})
   	{{- end}}
 	return err
 }
@@ -426,8 +861,16 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) error {
 {{if eq .Cmd ":execrows"}}
 {{range .Comments}}//{{.}}
 {{end -}}
-func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error) {
-	{{if .Arg.EmitStruct}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Args}}) (int64, error) {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		return 0, fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
 	{{$ConstantName := .ConstantName}}
 	{{$argNmae := .Arg.Name}}
 	{{$ConstantName}}:={{$ConstantName}}
@@ -447,9 +890,9 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, er
 	{{- end}}
 	{{- end}}
 	{{- if $.EmitPreparedQueries}}
-	result, err := q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	result, err := q.exec(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
   	{{- else}}
-	result, err := q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	result, err := q.db.ExecContext(ctx, {{.Text}}, {{.Params}})
   	{{- end}}
 	if err != nil {
 		return 0, err
@@ -461,8 +904,16 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, er
 {{if eq .Cmd ":execresult"}}
 {{range .Comments}}//{{.}}
 {{end -}}
-func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Result, error) {
-	{{if .Arg.EmitStruct}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Args}}) (sql.Result, error) {
+	{{- if .OrderBy}}
+	if !{{.OrderBy.Arg}}.Valid() {
+		return nil, fmt.Errorf("invalid {{.OrderBy.Arg}}: %q", {{.OrderBy.Arg}})
+	}
+	{{- end}}
+	{{- if .Optionals}}
+	{{.BindArgs}}
+	{{- end}}
+	{{if and .Arg.EmitStruct (not .Optionals)}}
 	{{$ConstantName := .ConstantName}}
 	{{$argNmae := .Arg.Name}}
 	{{$ConstantName}}:={{$ConstantName}}
@@ -493,9 +944,9 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Resul
 	
 	{{end -}}
 	{{- if $.EmitPreparedQueries}}
-	return q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	return q.exec(ctx, {{.Stmt}}, {{.Text}}, {{.Params}})
   	{{- else}}
-	return q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	return q.db.ExecContext(ctx, {{.Text}}, {{.Params}})
   	{{- end}}
 }
 {{end}}
@@ -546,6 +997,8 @@ type tmplCtx struct {
 	Q         string
 	Package   string
 	Enums     []Enum
+	Sets      []Set
+	NullUints []NullUint
 	Structs   []Struct
 	GoQueries []Query
 	Settings  config.Config
@@ -566,17 +1019,21 @@ func (t *tmplCtx) OutputQuery(sourceName string) bool {
 
 func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]string, error) {
 	enums := buildEnums(r, settings)
+	sets := buildSets(r, settings)
 	structs := buildStructs(r, settings)
 	queries := buildQueries(r, settings, structs)
-	return generate(settings, enums, structs, queries)
+	return generate(settings, enums, sets, structs, queries)
 }
 
-func generate(settings config.CombinedSettings, enums []Enum, structs []Struct, queries []Query) (map[string]string, error) {
+func generate(settings config.CombinedSettings, enums []Enum, sets []Set, structs []Struct, queries []Query) (map[string]string, error) {
+	nullUints := buildNullUints(structs, queries)
 	i := &importer{
-		Settings: settings,
-		Queries:  queries,
-		Enums:    enums,
-		Structs:  structs,
+		Settings:  settings,
+		Queries:   queries,
+		Enums:     enums,
+		Sets:      sets,
+		NullUints: nullUints,
+		Structs:   structs,
 	}
 
 	funcMap := template.FuncMap{
@@ -600,6 +1057,8 @@ func generate(settings config.CombinedSettings, enums []Enum, structs []Struct,
 		Package:             golang.Package,
 		GoQueries:           queries,
 		Enums:               enums,
+		Sets:                sets,
+		NullUints:           nullUints,
 		Structs:             structs,
 	}
 
@@ -58,10 +58,12 @@ func mergeImports(imps ...fileImports) [][]ImportSpec {
 }
 
 type importer struct {
-	Settings config.CombinedSettings
-	Queries  []Query
-	Enums    []Enum
-	Structs  []Struct
+	Settings  config.CombinedSettings
+	Queries   []Query
+	Enums     []Enum
+	Sets      []Set
+	NullUints []NullUint
+	Structs   []Struct
 }
 
 func (i *importer) usesType(typ string) bool {
@@ -244,8 +246,19 @@ func (i *importer) modelImports() fileImports {
 		}
 	}
 	if len(i.Enums) > 0 {
+		std["database/sql/driver"] = struct{}{}
 		std["fmt"] = struct{}{}
 	}
+	if len(i.Sets) > 0 {
+		std["database/sql/driver"] = struct{}{}
+		std["fmt"] = struct{}{}
+		std["strings"] = struct{}{}
+	}
+	if len(i.NullUints) > 0 {
+		std["database/sql/driver"] = struct{}{}
+		std["fmt"] = struct{}{}
+		std["strconv"] = struct{}{}
+	}
 
 	// Custom imports
 	pkg := make(map[ImportSpec]struct{})
@@ -315,6 +328,13 @@ func (i *importer) queryImports(filename string) fileImports {
 				if strings.HasPrefix(q.Ret.Type(), name) {
 					return true
 				}
+				if q.Nested != nil {
+					for _, f := range q.Nested.Nulls.Fields {
+						if strings.HasPrefix(strings.TrimPrefix(f.Type, "*"), name) {
+							return true
+						}
+					}
+				}
 			}
 			if !q.Arg.isEmpty() {
 				if q.Arg.EmitStruct() {
@@ -336,8 +356,12 @@ func (i *importer) queryImports(filename string) fileImports {
 	sliceScan := func() bool {
 		for _, q := range gq {
 			if q.hasRetType() {
-				if q.Ret.IsStruct() {
-					for _, f := range q.Ret.Struct.Fields {
+				if q.Nested != nil {
+					if strings.Contains(q.Nested.Scan(), "pq.Array(") {
+						return true
+					}
+				} else if q.Ret.IsStruct() {
+					for _, f := range q.Ret.Struct.columnFields() {
 						if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
 							return true
 						}
@@ -381,6 +405,18 @@ func (i *importer) queryImports(filename string) fileImports {
 		if q.Arg.ContainSlice() {
 			std["fmt"] = struct{}{}
 		}
+		if q.OrderBy != nil {
+			std["fmt"] = struct{}{}
+			std["strings"] = struct{}{}
+		}
+		if len(q.Optionals) > 0 {
+			std["strings"] = struct{}{}
+		}
+		if q.Paginate != nil {
+			std["encoding/base64"] = struct{}{}
+			std["encoding/json"] = struct{}{}
+			std["fmt"] = struct{}{}
+		}
 	}
 	for typeName, pkg := range stdlibTypes {
 		if uses(typeName) {
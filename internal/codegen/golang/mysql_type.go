@@ -2,6 +2,7 @@ package golang
 
 import (
 	"log"
+	"strings"
 
 	"github.com/xiazemin/sqlc/internal/compiler"
 	"github.com/xiazemin/sqlc/internal/config"
@@ -10,9 +11,39 @@ import (
 	"github.com/xiazemin/sqlc/internal/util"
 )
 
+// mysqlUnsignedType maps UNSIGNED integer columns to the unsigned Go type of
+// the same width. Nullable columns use the NullUint wrappers in models.go.
+func mysqlUnsignedType(col *compiler.Column, notNull bool) (string, bool) {
+	var typ string
+	switch col.DataType {
+	case "tinyint":
+		if col.Length != nil && *col.Length == 1 {
+			return "", false
+		}
+		typ = "uint8"
+	case "smallint":
+		typ = "uint16"
+	case "int", "integer", "mediumint":
+		typ = "uint32"
+	case "bigint":
+		typ = "uint64"
+	default:
+		return "", false
+	}
+	if notNull {
+		return typ, true
+	}
+	return "Null" + strings.Title(typ), true
+}
+
 func mysqlType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) string {
 	columnType := col.DataType
 	notNull := col.NotNull || col.IsArray
+	if col.Unsigned {
+		if typ, ok := mysqlUnsignedType(col, notNull); ok {
+			return typ
+		}
+	}
 	switch columnType {
 	case "varchar", "text", "char", "tinytext", "mediumtext", "longtext":
 		if notNull {
@@ -60,8 +91,10 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 		}
 		return "sql.NullString"
 
-	case "enum":
-		// TODO: Proper Enum support
+	case "enum", "set":
+		// ENUM and SET columns resolve to the <table>_<column> type in the
+		// default case; a bare one has lost its members and is read as a
+		// string.
 		if notNull {
 			return "string"
 		}
@@ -93,6 +126,19 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 			for _, typ := range schema.Types {
 				switch t := typ.(type) {
 				case *catalog.Enum:
+					if t.Name == columnType {
+						name := t.Name
+						if schema.Name != r.Catalog.DefaultSchema {
+							name = schema.Name + "_" + t.Name
+						}
+						// A NOT NULL enum has no NULL constant, so nullable
+						// uses get the wrapper type
+						if t.IsNotNull && !notNull {
+							return "Null" + StructName(name, settings)
+						}
+						return StructName(name, settings)
+					}
+				case *catalog.Set:
 					if t.Name == columnType {
 						if schema.Name == r.Catalog.DefaultSchema {
 							return StructName(t.Name, settings)
@@ -0,0 +1,81 @@
+package golang
+
+import (
+	"sort"
+	"strings"
+)
+
+// NullUint is a nullable unsigned integer type written to models.go.
+// database/sql has no Null wrappers for unsigned integers, so one is
+// generated for every width used by a nullable unsigned column.
+type NullUint struct {
+	Name  string
+	Type  string
+	Field string
+	Bits  int
+}
+
+// ValueType is the type returned by Value. The MySQL driver accepts uint64
+// from a Valuer; narrower widths fit in an int64.
+func (n NullUint) ValueType() string {
+	if n.Bits == 64 {
+		return "uint64"
+	}
+	return "int64"
+}
+
+var nullUints = map[string]NullUint{
+	"NullUint8":  {Name: "NullUint8", Type: "uint8", Field: "Uint8", Bits: 8},
+	"NullUint16": {Name: "NullUint16", Type: "uint16", Field: "Uint16", Bits: 16},
+	"NullUint32": {Name: "NullUint32", Type: "uint32", Field: "Uint32", Bits: 32},
+	"NullUint64": {Name: "NullUint64", Type: "uint64", Field: "Uint64", Bits: 64},
+}
+
+func buildNullUints(structs []Struct, queries []Query) []NullUint {
+	seen := map[string]struct{}{}
+	addType := func(typ string) {
+		typ = strings.TrimLeft(typ, "[]*")
+		if _, ok := nullUints[typ]; ok {
+			seen[typ] = struct{}{}
+		}
+	}
+	var addFields func(fields []Field)
+	addFields = func(fields []Field) {
+		for _, f := range fields {
+			addType(f.Type)
+			addFields(f.EmbedFields)
+		}
+	}
+	var addValue func(v *QueryValue)
+	addValue = func(v *QueryValue) {
+		addType(v.Typ)
+		if v.Struct != nil {
+			addFields(v.Struct.Fields)
+		}
+		for _, s := range v.Slice {
+			addValue(s)
+		}
+	}
+
+	for _, s := range structs {
+		addFields(s.Fields)
+	}
+	for i := range queries {
+		q := &queries[i]
+		addValue(&q.Arg)
+		addValue(&q.Ret)
+		if q.Paginate != nil && q.Paginate.Cursor != nil {
+			addFields(q.Paginate.Cursor.Fields)
+		}
+		if q.Nested != nil && q.Nested.Nulls != nil {
+			addFields(q.Nested.Nulls.Fields)
+		}
+	}
+
+	types := make([]NullUint, 0, len(seen))
+	for name := range seen {
+		types = append(types, nullUints[name])
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Bits < types[j].Bits })
+	return types
+}
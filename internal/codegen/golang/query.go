@@ -15,6 +15,11 @@ type QueryValue struct {
 	IsSlice   bool
 	Slice     []*QueryValue
 	NameSpace string
+
+	// Positions lists, for every placeholder in the query, the index of the
+	// struct field bound to it. It is only set when a named parameter is used
+	// more than once in a query whose engine has positional placeholders.
+	Positions []int
 }
 
 func (v QueryValue) EmitStruct() bool {
@@ -53,7 +58,7 @@ func (v QueryValue) GetDefaultValueByType() string {
 		case "interface{}":
 			return "nil"
 		}
-		if strings.HasPrefix(v.Typ, "sql.Null") {
+		if strings.HasPrefix(v.Typ, "sql.Null") || strings.HasPrefix(v.Typ, "NullUint") {
 			return v.Typ + "{}"
 		}
 		if strings.HasPrefix(v.Typ, "*") || strings.HasPrefix(v.Typ, "[]") {
@@ -255,6 +260,19 @@ func formatType(typ string) string {
 	return typ
 }
 
+// boundFields returns the struct fields in the order they are bound to the
+// query placeholders, repeating fields that are referenced more than once.
+func (v QueryValue) boundFields() []Field {
+	if len(v.Positions) == 0 {
+		return v.Struct.Fields
+	}
+	fields := make([]Field, len(v.Positions))
+	for i, p := range v.Positions {
+		fields[i] = v.Struct.Fields[p]
+	}
+	return fields
+}
+
 func (v QueryValue) Params() string {
 	if v.isEmpty() {
 		return ""
@@ -270,12 +288,15 @@ func (v QueryValue) Params() string {
 				out = append(out, v.Name)
 			}
 		}
+		for i := 1; i < len(v.Positions); i++ {
+			out = append(out, out[0])
+		}
 	} else {
 		if v.ContainSlice() {
 			//append(append([]interface{}{arg.Bio}, int32Slice2interface(arg.ID)...), stringSlice2interface(arg.Name)...)...
 			out := ""
 
-			for _, f := range v.Struct.Fields {
+			for _, f := range v.boundFields() {
 				if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
 					out = fmt.Sprintf(out, "pq.Array("+v.Name+"."+f.Name+")")
 				} else if f.IsSlice {
@@ -296,7 +317,7 @@ func (v QueryValue) Params() string {
 			}
 			return out + "..."
 		} else {
-			for _, f := range v.Struct.Fields {
+			for _, f := range v.boundFields() {
 				if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
 					out = append(out, "pq.Array("+v.Name+"."+f.Name+")")
 				} else {
@@ -321,7 +342,7 @@ func (v QueryValue) Scan() string {
 			out = append(out, "&"+v.Name)
 		}
 	} else {
-		for _, f := range v.Struct.Fields {
+		for _, f := range v.Struct.columnFields() {
 			if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
 				out = append(out, "pq.Array(&"+v.Name+"."+f.Name+")")
 			} else {
@@ -336,6 +357,81 @@ func (v QueryValue) Scan() string {
 	return "\n" + strings.Join(out, ",\n")
 }
 
+// OrderBy is the sort type generated for a sqlc.order_by call. Each constant
+// holds an ORDER BY fragment that replaces Marker in the query text.
+type OrderBy struct {
+	Enum
+	Arg    string
+	Marker string
+}
+
+// Optional is a fragment of the query text replaced by Replacement, and its
+// arguments dropped, when the argument field at Index is unset.
+type Optional struct {
+	Index       int
+	Fragment    string
+	Replacement string
+
+	// UserType is "enum" or "set" when the argument has a generated enum or
+	// set type, which are tested differently from database/sql types.
+	UserType string
+
+	// KeepArgs is set when the arguments are passed even if unset.
+	KeepArgs bool
+}
+
+// Paginate holds the next-page query and cursor type of a :paginate query.
+type Paginate struct {
+	ConstantName string
+	SQL          string
+	Cursor       *Struct
+	Last         []string // expression reading each cursor field from the last row
+	Binds        []string // cursor fields bound to the placeholders of SQL
+}
+
+// CursorFields returns the composite literal fields of a cursor built from
+// the last row of a page.
+func (p Paginate) CursorFields() string {
+	fields := make([]string, len(p.Cursor.Fields))
+	for i, f := range p.Cursor.Fields {
+		fields[i] = f.Name + ": " + p.Last[i]
+	}
+	return strings.Join(fields, ", ")
+}
+
+// BindArgs returns the cursor arguments of the next-page query.
+func (p Paginate) BindArgs() string {
+	return strings.Join(p.Binds, ", ")
+}
+
+// Nested folds the rows of a :nested query into one result per parent row.
+// An outer join without a match returns NULL for every child column, so the
+// child columns that cannot hold NULL are scanned into the pointers of Nulls
+// and copied into the child once its key is known to be set.
+type Nested struct {
+	KeyType string
+	Key     string // expression computing the parent key of row i
+	Field   string // child slice field of the result struct
+	Child   string // child model struct
+	Nulls   *Struct
+	Present string   // expression reporting whether the row holds a child
+	Copy    []string // statements copying Nulls into the child
+	Targets []string // scan destinations, in column order
+}
+
+func (n Nested) NullsType() string {
+	fields := make([]string, len(n.Nulls.Fields))
+	for i, f := range n.Nulls.Fields {
+		fields[i] = f.Name + " " + f.Type
+	}
+	return "struct {\n" + strings.Join(fields, "\n") + "\n}"
+}
+
+func (n Nested) Scan() string {
+	out := append(append([]string(nil), n.Targets...), "")
+	return "\n" + strings.Join(out, ",\n")
+}
+
 // A struct used to generate methods and fields on the Queries struct
 type Query struct {
 	Cmd          string
@@ -347,9 +443,139 @@ type Query struct {
 	SourceName   string
 	Ret          QueryValue
 	Arg          QueryValue
+	OrderBy      *OrderBy
+	Optionals    []Optional
+	Paginate     *Paginate
+	Nested       *Nested
+}
+
+// Args returns the method parameters that follow the context.
+func (q Query) Args() string {
+	var args []string
+	if pair := q.Arg.Pair(); pair != "" {
+		args = append(args, pair)
+	}
+	if q.OrderBy != nil {
+		args = append(args, q.OrderBy.Arg+" "+q.OrderBy.Name)
+	}
+	return strings.Join(args, ", ")
+}
+
+// Stmt returns the prepared statement for the query. Queries assembled at run
+// time have no fixed text and are never run through a prepared statement.
+func (q Query) Stmt() string {
+	if q.OrderBy != nil || len(q.Optionals) > 0 || q.Paginate != nil {
+		return "nil"
+	}
+	return "q." + q.FieldName
+}
+
+// Text returns the expression holding the query text sent to the database.
+func (q Query) Text() string {
+	text := q.ConstantName
+	if len(q.Optionals) > 0 || q.Paginate != nil {
+		text = "query"
+	}
+	if q.OrderBy == nil {
+		return text
+	}
+	return fmt.Sprintf("strings.Replace(%s, %q, string(%s), 1)", text, q.OrderBy.Marker, q.OrderBy.Arg)
+}
+
+// Params returns the arguments passed to the database.
+func (q Query) Params() string {
+	if len(q.Optionals) > 0 || q.Paginate != nil {
+		return "args..."
+	}
+	return q.Arg.Params()
+}
+
+// BindArgs returns the statements building the query text and arguments of a
+// query with optional fragments.
+func (q Query) BindArgs() string {
+	optional := map[int]Optional{}
+	for _, o := range q.Optionals {
+		optional[o.Index] = o
+	}
+	expr := func(index int) string {
+		if q.Arg.Struct == nil {
+			return q.Arg.Name
+		}
+		return q.Arg.Name + "." + q.Arg.Struct.Fields[index].Name
+	}
+	typ := func(index int) string {
+		if q.Arg.Struct == nil {
+			return q.Arg.Typ
+		}
+		return q.Arg.Struct.Fields[index].Type
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "query := %s\n", q.ConstantName)
+	b.WriteString("\tvar args []interface{}\n")
+	positions := q.Arg.Positions
+	if len(positions) == 0 {
+		// Numbered placeholders take the arguments in parameter order
+		n := 1
+		if q.Arg.Struct != nil {
+			n = len(q.Arg.Struct.Fields)
+		}
+		for i := 0; i < n; i++ {
+			positions = append(positions, i)
+		}
+	}
+	for i := 0; i < len(positions); {
+		index := positions[i]
+		j := i
+		var group []string
+		for j < len(positions) && positions[j] == index {
+			group = append(group, expr(index))
+			j++
+		}
+		o, ok := optional[index]
+		if !ok {
+			fmt.Fprintf(&b, "\targs = append(args, %s)\n", strings.Join(group, ", "))
+		} else if o.KeepArgs {
+			fmt.Fprintf(&b, "\targs = append(args, %s)\n", strings.Join(group, ", "))
+			fmt.Fprintf(&b, "\tif %s {\n", isUnset(expr(index), typ(index), o.UserType))
+			fmt.Fprintf(&b, "\t\tquery = strings.Replace(query, %q, %q, 1)\n", o.Fragment, o.Replacement)
+			b.WriteString("\t}\n")
+		} else {
+			fmt.Fprintf(&b, "\tif %s {\n", isSet(expr(index), typ(index), o.UserType))
+			fmt.Fprintf(&b, "\t\targs = append(args, %s)\n", strings.Join(group, ", "))
+			b.WriteString("\t} else {\n")
+			fmt.Fprintf(&b, "\t\tquery = strings.Replace(query, %q, %q, 1)\n", o.Fragment, o.Replacement)
+			b.WriteString("\t}\n")
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// isSet returns a boolean expression reporting whether the nullable value
+// expr of type typ holds a value.
+func isSet(expr, typ, userType string) string {
+	switch {
+	case userType == "enum":
+		return expr + ".Valid()"
+	case userType == "set":
+		return expr + " != nil"
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"), typ == "interface{}", typ == "json.RawMessage":
+		return expr + " != nil"
+	}
+	return expr + ".Valid"
+}
+
+// isUnset is the negation of isSet.
+func isUnset(expr, typ, userType string) string {
+	set := isSet(expr, typ, userType)
+	if strings.HasSuffix(set, " != nil") {
+		return strings.TrimSuffix(set, " != nil") + " == nil"
+	}
+	return "!" + set
 }
 
 func (q Query) hasRetType() bool {
-	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany
+	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany || q.Cmd == metadata.CmdIter || q.Cmd == metadata.CmdPaginate || q.Cmd == metadata.CmdNested
 	return scanned && !q.Ret.isEmpty()
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/xiazemin/sqlc/internal/config"
 	"github.com/xiazemin/sqlc/internal/core"
 	"github.com/xiazemin/sqlc/internal/inflection"
+	"github.com/xiazemin/sqlc/internal/sql/ast"
 	"github.com/xiazemin/sqlc/internal/sql/catalog"
 	"github.com/xiazemin/sqlc/internal/util"
 )
@@ -46,9 +47,12 @@ func buildEnums(r *compiler.Result, settings config.CombinedSettings) []Enum {
 					Type:  e.Name,
 				})
 			}
-			if !enum.IsNotNull {
+			if enum.IsNotNull {
+				e.EmitNull = nullableEnumUse(r, enum.Name)
+			} else {
+				e.Null = StructName(enumName+"_"+"NULL", settings)
 				e.Constants = append(e.Constants, Constant{
-					Name:  StructName(enumName+"_"+"NULL", settings),
+					Name:  e.Null,
 					Value: "null",
 					Type:  e.Name,
 				})
@@ -62,6 +66,51 @@ func buildEnums(r *compiler.Result, settings config.CombinedSettings) []Enum {
 	return enums
 }
 
+// nullableEnumUse reports whether a query has a nullable column or parameter
+// of the enum type, such as a sqlc.narg() compared with an enum column.
+func nullableEnumUse(r *compiler.Result, name string) bool {
+	nullable := func(c *compiler.Column) bool {
+		return c != nil && c.DataType == name && !c.NotNull && !c.IsArray
+	}
+	for _, q := range r.Queries {
+		for _, c := range q.Columns {
+			if nullable(c) {
+				return true
+			}
+		}
+		for _, p := range q.Params {
+			if nullable(p.Column) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func buildSets(r *compiler.Result, settings config.CombinedSettings) []Set {
+	var sets []Set
+	for _, schema := range r.Catalog.Schemas {
+		for _, typ := range schema.Types {
+			set, ok := typ.(*catalog.Set)
+			if !ok {
+				continue
+			}
+			setName := set.Name
+			if schema.Name != r.Catalog.DefaultSchema {
+				setName = schema.Name + "_" + set.Name
+			}
+			sets = append(sets, Set{
+				Name:      StructName(setName, settings),
+				Comment:   set.Comment,
+				IsNotNull: set.IsNotNull,
+				Members:   set.Vals,
+			})
+		}
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i].Name < sets[j].Name })
+	return sets
+}
+
 func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct {
 	var structs []Struct
 	for _, schema := range r.Catalog.Schemas {
@@ -96,7 +145,7 @@ func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct
 					Name:    StructName(column.Name, settings),
 					Type:    goType(r, compiler.ConvertColumn(table.Rel, column), settings),
 					Tags:    tags,
-					Comment: column.Comment,
+					Comment: columnComment(column),
 				})
 			}
 			structs = append(structs, s)
@@ -108,6 +157,48 @@ func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct
 	return structs
 }
 
+// userType returns "enum" or "set" when a column has a generated enum or set
+// type, and an empty string otherwise.
+func userType(r *compiler.Result, col *compiler.Column) string {
+	if col == nil {
+		return ""
+	}
+	for _, schema := range r.Catalog.Schemas {
+		for _, typ := range schema.Types {
+			switch t := typ.(type) {
+			case *catalog.Enum:
+				if t.Name == col.DataType {
+					return "enum"
+				}
+			case *catalog.Set:
+				if t.Name == col.DataType {
+					return "set"
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// columnComment returns the comment of a model field: the column comment,
+// followed by the column's default or generation expression. Serial, identity
+// and AUTO_INCREMENT columns have no expression and are noted as such.
+func columnComment(column *catalog.Column) string {
+	var lines []string
+	if column.Comment != "" {
+		lines = append(lines, column.Comment)
+	}
+	if column.Default != "" && !strings.EqualFold(column.Default, "NULL") {
+		lines = append(lines, "Default: "+column.Default)
+	} else if column.IsAutoIncrement && column.Default == "" {
+		lines = append(lines, "Default: auto increment")
+	}
+	if column.Generated != "" {
+		lines = append(lines, "Generated: "+column.Generated)
+	}
+	return strings.Join(lines, "\n")
+}
+
 type goColumn struct {
 	id int
 	*compiler.Column
@@ -191,8 +282,34 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				NameSpace: settings.Go.Package,
 			}
 		}
+		if query.OrderBy != nil {
+			gq.OrderBy = buildOrderBy(gq.MethodName, query.OrderBy, settings)
+		}
+		if len(query.ParamOrder) > 0 {
+			index := make(map[int]int, len(query.Params))
+			for i, p := range query.Params {
+				index[p.Number] = i
+			}
+			for _, num := range query.ParamOrder {
+				gq.Arg.Positions = append(gq.Arg.Positions, index[num])
+			}
+		}
+		for _, o := range query.Optionals {
+			for i, p := range query.Params {
+				if p.Number == o.Param {
+					gq.Optionals = append(gq.Optionals, Optional{
+						Index:       i,
+						Fragment:    o.Fragment,
+						Replacement: o.Replacement,
+						UserType:    userType(r, p.Column),
+						KeepArgs:    o.KeepArgs,
+					})
+				}
+			}
+		}
 
-		if len(query.Columns) == 1 {
+		embedded := hasEmbed(query.Columns)
+		if len(query.Columns) == 1 && !embedded {
 			c := query.Columns[0]
 			gq.Ret = QueryValue{
 				Name:      columnName(c, 0),
@@ -200,11 +317,14 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				IsSlice:   isSlice(c),
 				NameSpace: settings.Go.Package,
 			}
-		} else if len(query.Columns) > 1 {
+		} else if len(query.Columns) > 1 || embedded {
 			var gs *Struct
 			var emit bool
 
 			for _, s := range structs {
+				if embedded {
+					break
+				}
 				if len(s.Fields) != len(query.Columns) {
 					continue
 				}
@@ -233,7 +353,11 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 						IsSlice: isSlice(c),
 					})
 				}
-				gs = columnsToStruct(r, gq.MethodName+"Row", columns, settings)
+				if embedded {
+					gs = embedStruct(r, gq.MethodName+"Row", columns, structs, settings)
+				} else {
+					gs = columnsToStruct(r, gq.MethodName+"Row", columns, settings)
+				}
 				emit = true
 			}
 			gq.Ret = QueryValue{
@@ -244,6 +368,12 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			}
 		}
 		util.Xiazeminlog(" result gq", gq, false)
+		if query.Paginate != nil {
+			gq.Paginate = buildPaginate(gq, query.Paginate, settings)
+		}
+		if query.Nested != nil {
+			gq.Nested = buildNested(&gq, query.Columns, query.Nested, settings)
+		}
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
@@ -304,3 +434,206 @@ func columnsToStruct(r *compiler.Result, name string, columns []goColumn, settin
 	}
 	return &gs
 }
+
+func hasEmbed(columns []*compiler.Column) bool {
+	for _, c := range columns {
+		if c.EmbedTable != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// embedStruct builds the result struct of a query using sqlc.embed(). Each
+// embedded column group becomes a single field holding the model struct of
+// its table; the remaining columns are named as in columnsToStruct.
+func embedStruct(r *compiler.Result, name string, columns []goColumn, structs []Struct, settings config.CombinedSettings) *Struct {
+	// A table without a model struct can't be embedded, so its columns are
+	// kept as plain columns
+	embedded := func(c goColumn) bool {
+		return c.EmbedTable != nil && embedModel(r, c.EmbedTable, structs) != nil
+	}
+	var plain []goColumn
+	for _, c := range columns {
+		if !embedded(c) {
+			plain = append(plain, c)
+		}
+	}
+	plainFields := columnsToStruct(r, name, plain, settings).Fields
+
+	gs := Struct{Name: name}
+	seen := map[string]int{}
+	seenTags := map[string]int{}
+	for i := 0; i < len(columns); {
+		c := columns[i]
+		if !embedded(c) {
+			gs.Fields = append(gs.Fields, plainFields[0])
+			plainFields = plainFields[1:]
+			i++
+			continue
+		}
+		j := i
+		for j < len(columns) && columns[j].EmbedTable == c.EmbedTable {
+			j++
+		}
+		model := embedModel(r, c.EmbedTable, structs)
+		fieldName := model.Name
+		if v := seen[model.Name]; v > 0 {
+			fieldName = fmt.Sprintf("%s_%d", fieldName, v+1)
+		}
+		seen[model.Name]++
+		// Dedupe the tags too, as a self join embeds the same table twice
+		tagName := inflection.Singular(c.EmbedTable.Name)
+		if v := seenTags[tagName]; v > 0 {
+			seenTags[tagName]++
+			tagName = fmt.Sprintf("%s_%d", tagName, v+1)
+		} else {
+			seenTags[tagName]++
+		}
+		tags := map[string]string{}
+		if settings.Go.EmitDBTags {
+			tags["db:"] = tagName
+		}
+		if settings.Go.EmitJSONTags {
+			tags["json:"] = tagName
+		}
+		gs.Fields = append(gs.Fields, Field{
+			Name:        fieldName,
+			Type:        model.Name,
+			Tags:        tags,
+			EmbedFields: model.Fields,
+		})
+		i = j
+	}
+	return &gs
+}
+
+// embedModel returns the model struct of an embedded table, or nil if the
+// table has none.
+func embedModel(r *compiler.Result, table *ast.TableName, structs []Struct) *Struct {
+	for k := range structs {
+		if sameTableName(table, structs[k].Table, r.Catalog.DefaultSchema) {
+			return &structs[k]
+		}
+	}
+	return nil
+}
+
+// buildNested replaces the embedded child of the result struct with a slice
+// of children, and describes how each row is folded into its parent.
+func buildNested(gq *Query, columns []*compiler.Column, n *compiler.Nested, settings config.CombinedSettings) *Nested {
+	parent, child := gq.Ret.Struct.Fields[0], gq.Ret.Struct.Fields[1]
+	tags := map[string]string{}
+	tagName := columns[n.Child].EmbedTable.Name
+	if settings.Go.EmitDBTags {
+		tags["db:"] = tagName
+	}
+	if settings.Go.EmitJSONTags {
+		tags["json:"] = tagName
+	}
+	field := StructName(tagName, settings)
+	if field == parent.Name {
+		field += "_2"
+	}
+	gq.Ret.Struct = &Struct{
+		Name: gq.Ret.Struct.Name,
+		Fields: []Field{parent, {
+			Name: field,
+			Type: "[]" + child.Type,
+			Tags: tags,
+		}},
+	}
+
+	nested := &Nested{
+		Field: field,
+		Child: child.Type,
+		Nulls: &Struct{},
+	}
+	scan := func(expr, typ string) string {
+		if strings.HasPrefix(typ, "[]") && typ != "[]byte" {
+			return "pq.Array(&" + expr + ")"
+		}
+		return "&" + expr
+	}
+	for _, f := range parent.EmbedFields {
+		nested.Targets = append(nested.Targets, scan("i."+parent.Name+"."+f.Name, f.Type))
+	}
+	for i, f := range child.EmbedFields {
+		c := columns[n.Child+i]
+		nullable := !c.NotNull || strings.HasPrefix(f.Type, "[]")
+		if nullable {
+			nested.Targets = append(nested.Targets, scan("child."+f.Name, f.Type))
+		} else {
+			nested.Nulls.Fields = append(nested.Nulls.Fields, Field{Name: f.Name, Type: "*" + f.Type})
+			nested.Targets = append(nested.Targets, "&nulls."+f.Name)
+			nested.Copy = append(nested.Copy, "child."+f.Name+" = *nulls."+f.Name)
+		}
+		if n.Child+i == n.ChildKey {
+			if nullable {
+				nested.Present = isSet("child."+f.Name, f.Type, "")
+			} else {
+				nested.Present = "nulls." + f.Name + " != nil"
+			}
+		}
+	}
+
+	var keyFields, keyExprs []string
+	for _, k := range n.Key {
+		f := parent.EmbedFields[k]
+		keyFields = append(keyFields, f.Name+" "+f.Type)
+		keyExprs = append(keyExprs, "i."+parent.Name+"."+f.Name)
+	}
+	if len(n.Key) == 1 {
+		nested.KeyType = parent.EmbedFields[n.Key[0]].Type
+		nested.Key = keyExprs[0]
+	} else {
+		nested.KeyType = "struct {\n" + strings.Join(keyFields, "\n") + "\n}"
+		nested.Key = nested.KeyType + "{" + strings.Join(keyExprs, ", ") + "}"
+	}
+	return nested
+}
+
+func buildPaginate(gq Query, p *compiler.Paginate, settings config.CombinedSettings) *Paginate {
+	page := &Paginate{
+		ConstantName: gq.ConstantName + "Next",
+		SQL:          p.SQL,
+		Cursor:       &Struct{Name: codegen.LowerTitle(gq.MethodName) + "Cursor"},
+	}
+	for _, key := range p.Keys {
+		var f Field
+		if gq.Ret.Struct != nil {
+			f = gq.Ret.Struct.columnFields()[key.Column]
+			page.Last = append(page.Last, "last."+f.Name)
+			f.Name = strings.Replace(f.Name, ".", "", -1)
+		} else {
+			f = Field{Name: StructName(gq.Ret.Name, settings), Type: gq.Ret.Typ}
+			page.Last = append(page.Last, "last")
+		}
+		page.Cursor.Fields = append(page.Cursor.Fields, Field{
+			Name: f.Name,
+			Type: f.Type,
+			Tags: map[string]string{"json:": strings.ToLower(f.Name)},
+		})
+	}
+	for _, key := range p.Binds {
+		page.Binds = append(page.Binds, "key."+page.Cursor.Fields[key].Name)
+	}
+	return page
+}
+
+func buildOrderBy(method string, o *compiler.OrderBy, settings config.CombinedSettings) *OrderBy {
+	typ := method + StructName(o.Name, settings)
+	ob := &OrderBy{
+		Enum:   Enum{Name: typ, IsNotNull: true},
+		Arg:    o.Name,
+		Marker: o.Marker,
+	}
+	for _, column := range o.Columns {
+		name := typ + StructName(strings.Replace(column, ".", "_", -1), settings)
+		ob.Constants = append(ob.Constants,
+			Constant{Name: name + "Asc", Value: o.Fragment(column, false), Type: typ},
+			Constant{Name: name + "Desc", Value: o.Fragment(column, true), Type: typ},
+		)
+	}
+	return ob
+}
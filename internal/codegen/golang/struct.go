@@ -28,3 +28,20 @@ func StructName(name string, settings config.CombinedSettings) string {
 	}
 	return out
 }
+
+// columnFields returns one field per result column, naming the fields of
+// embedded model structs by their path, such as "User.ID".
+func (gs Struct) columnFields() []Field {
+	var fields []Field
+	for _, f := range gs.Fields {
+		if f.EmbedFields == nil {
+			fields = append(fields, f)
+			continue
+		}
+		for _, ef := range f.EmbedFields {
+			ef.Name = f.Name + "." + ef.Name
+			fields = append(fields, ef)
+		}
+	}
+	return fields
+}
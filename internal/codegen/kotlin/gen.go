@@ -3,6 +3,7 @@ package kotlin
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -14,6 +15,7 @@ import (
 	"github.com/xiazemin/sqlc/internal/config"
 	"github.com/xiazemin/sqlc/internal/core"
 	"github.com/xiazemin/sqlc/internal/inflection"
+	"github.com/xiazemin/sqlc/internal/metadata"
 	"github.com/xiazemin/sqlc/internal/sql/ast"
 	"github.com/xiazemin/sqlc/internal/sql/catalog"
 )
@@ -93,10 +95,14 @@ func jdbcSet(t ktType, idx int, name string) string {
 		return fmt.Sprintf(`stmt.setArray(%d, conn.createArrayOf("%s", %s.map { v -> v.value }.toTypedArray()))`, idx, t.DataType, name)
 	}
 	if t.IsEnum {
+		value := name + ".value"
+		if t.IsNull {
+			value = name + "?.value"
+		}
 		if t.Engine == config.EnginePostgreSQL {
-			return fmt.Sprintf("stmt.setObject(%d, %s.value, %s)", idx, name, "Types.OTHER")
+			return fmt.Sprintf("stmt.setObject(%d, %s, %s)", idx, value, "Types.OTHER")
 		} else {
-			return fmt.Sprintf("stmt.setString(%d, %s.value)", idx, name)
+			return fmt.Sprintf("stmt.setString(%d, %s)", idx, value)
 		}
 	}
 	if t.IsArray {
@@ -149,6 +155,9 @@ func jdbcGet(t ktType, idx int) string {
 		return fmt.Sprintf(`(results.getArray(%d).array as Array<String>).map { v -> %s.lookup(v)!! }.toList()`, idx, t.Name)
 	}
 	if t.IsEnum {
+		if t.IsNull {
+			return fmt.Sprintf("results.getString(%d)?.let { %s.lookup(it)!! }", idx, t.Name)
+		}
 		return fmt.Sprintf("%s.lookup(results.getString(%d))!!", t.Name, idx)
 	}
 	if t.IsArray {
@@ -474,6 +483,17 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				Column: p.Column,
 			})
 		}
+		if len(query.ParamOrder) > 0 {
+			// Bind repeated named parameters once per "?" placeholder
+			byNumber := make(map[int]goColumn, len(cols))
+			for _, c := range cols {
+				byNumber[c.id] = c
+			}
+			cols = cols[:0]
+			for _, num := range query.ParamOrder {
+				cols = append(cols, byNumber[num])
+			}
+		}
 		params := ktColumnsToStruct(r, gq.ClassName+"Bindings", cols, settings, ktParamName)
 		gq.Arg = Params{
 			Struct: params,
@@ -754,7 +774,32 @@ func ktFormat(s string) string {
 	return o
 }
 
+// checkQueries rejects query commands and macros the Kotlin templates have no
+// code for, which would otherwise be emitted as a bare SQL constant or
+// silently ignored.
+func checkQueries(r *compiler.Result, settings config.CombinedSettings) error {
+	if settings.Go.EmitInsertDefaults {
+		return errors.New("emit_insert_defaults is not supported by the Kotlin generator")
+	}
+	for _, query := range r.Queries {
+		switch query.Cmd {
+		case metadata.CmdIter, metadata.CmdPaginate, metadata.CmdNested:
+			return fmt.Errorf("%s: %s queries are not supported by the Kotlin generator", query.Name, query.Cmd)
+		}
+		if query.OrderBy != nil {
+			return fmt.Errorf("%s: sqlc.order_by is not supported by the Kotlin generator", query.Name)
+		}
+		if len(query.Optionals) > 0 {
+			return fmt.Errorf("%s: sqlc.optional is not supported by the Kotlin generator", query.Name)
+		}
+	}
+	return nil
+}
+
 func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]string, error) {
+	if err := checkQueries(r, settings); err != nil {
+		return nil, err
+	}
 	enums := buildEnums(r, settings)
 	structs := buildDataClasses(r, settings)
 	queries := buildQueries(r, settings, structs)
@@ -32,8 +32,9 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 	case "decimal", "dec", "fixed":
 		return "String", false
 
-	case "enum":
-		// TODO: Proper Enum support
+	case "enum", "set":
+		// ENUM columns resolve to the <table>_<column> enum in the default
+		// case; SET columns and a bare ENUM are read as a string.
 		return "String", false
 
 	case "date", "datetime", "time":
@@ -62,6 +63,10 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 						}
 						return DataClassName(schema.Name+"_"+t.Name, settings), true
 					}
+				case *catalog.Set:
+					if t.Name == columnType {
+						return "String", false
+					}
 				}
 			}
 		}
@@ -14,7 +14,6 @@ import (
 	"github.com/xiazemin/sqlc/internal/multierr"
 	"github.com/xiazemin/sqlc/internal/opts"
 	"github.com/xiazemin/sqlc/internal/sql/ast"
-	"github.com/xiazemin/sqlc/internal/sql/catalog"
 	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
 	"github.com/xiazemin/sqlc/internal/sql/sqlpath"
 	"github.com/xiazemin/sqlc/internal/util"
@@ -55,7 +54,7 @@ func enumValueName(value string) string {
 }
 
 // end copypasta
-func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
+func (c *Compiler) parseCatalog(schemas []string) error {
 	files, err := sqlpath.Glob(schemas)
 	if err != nil {
 		return err
@@ -67,14 +66,14 @@ func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
 			merr.Add(filename, "", 0, err)
 			continue
 		}
-		contents := migrations.RemoveRollbackStatements(string(blob))
-		stmts, err := p.Parse(strings.NewReader(contents))
+		contents, _ := blankNotNullCasts(blankOrderByLabels(migrations.RemoveRollbackStatements(string(blob))))
+		stmts, err := c.parser.Parse(strings.NewReader(contents))
 		if err != nil {
 			merr.Add(filename, contents, 0, err)
 			continue
 		}
 		for i := range stmts {
-			if err := c.Update(stmts[i]); err != nil {
+			if err := c.catalog.Update(stmts[i], c); err != nil {
 				merr.Add(filename, contents, stmts[i].Pos(), err)
 				continue
 			}
@@ -100,7 +99,7 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 			merr.Add(filename, "", 0, err)
 			continue
 		}
-		src := string(blob)
+		src, notNull := blankNotNullCasts(blankOrderByLabels(string(blob)))
 		// 从原文件，得到一棵棵语法树 根节点
 		stmts, err := c.parser.Parse(strings.NewReader(src))
 		util.Xiazeminlog("query stmts", stmts, false)
@@ -110,7 +109,7 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 		}
 		for _, stmt := range stmts {
 			//解析查询
-			query, err := c.parseQuery(stmt.Raw, src, o)
+			query, err := c.parseQuery(stmt.Raw, src, notNull, o)
 			util.Xiazeminlog(stmt.GetOpName(), query, false)
 			if err == ErrUnsupportedStatementType {
 				continue
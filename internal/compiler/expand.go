@@ -74,6 +74,24 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 		if !ok {
 			continue
 		}
+		if call, ok := res.Val.(*ast.FuncCall); ok && isEmbed(call) {
+			t, scope, err := embedTable(tables, call)
+			if err != nil {
+				return nil, err
+			}
+			scopeName := c.quoteIdent(scope)
+			var cols []string
+			for _, column := range t.Columns {
+				cols = append(cols, scopeName+"."+c.quoteIdent(column.Name))
+			}
+			// TODO: This code assumes that sqlc.embed(table) has no spaces
+			edits = append(edits, source.Edit{
+				Location: call.Location - raw.StmtLocation,
+				Old:      fmt.Sprintf("sqlc.embed(%s)", scope),
+				New:      strings.Join(cols, ", "),
+			})
+			continue
+		}
 		ref, ok := res.Val.(*ast.ColumnRef)
 		if !ok {
 			continue
@@ -1,6 +1,8 @@
 package compiler
 
 import (
+	"strings"
+
 	"github.com/xiazemin/sqlc/internal/sql/ast"
 	"github.com/xiazemin/sqlc/internal/sql/astutils"
 	"github.com/xiazemin/sqlc/internal/util"
@@ -18,6 +20,9 @@ type paramRef struct {
 	rv     *ast.RangeVar
 	ref    *ast.ParamRef
 	name   string // Named parameter support
+
+	// scope holds the tables of the innermost SELECT containing the parameter
+	scope []*ast.RangeVar
 }
 
 func (p *paramRef) GetName() string {
@@ -33,6 +38,15 @@ type paramSearch struct {
 	// XXX: Gross state hack for limit
 	limitCount  ast.Node
 	limitOffset ast.Node
+
+	// having is set while searching a HAVING clause
+	having bool
+
+	// call and window are the nearest enclosing function call and window
+	call   *ast.FuncCall
+	window *ast.WindowDef
+
+	scope []*ast.RangeVar
 }
 
 type limitCount struct {
@@ -49,14 +63,78 @@ func (l *limitOffset) Pos() int {
 	return 0
 }
 
+// aggregateRef is the parent of a parameter compared against an aggregate
+// function in a HAVING clause.
+type aggregateRef struct {
+	call *ast.FuncCall
+}
+
+func (a *aggregateRef) Pos() int {
+	return a.call.Pos()
+}
+
+// castRef is the parent of a parameter whose type comes from a cast and
+// whose name comes from where the cast appears, such as the quantity of an
+// INTERVAL or the offset of a window frame.
+type castRef struct {
+	cast *ast.TypeCast
+	name string
+}
+
+func (c *castRef) Pos() int {
+	return c.cast.Pos()
+}
+
+// castName returns a parameter name for cast if it is the quantity of an
+// INTERVAL in a date arithmetic call or the offset of a window frame.
+func (p paramSearch) castName(cast *ast.TypeCast) string {
+	if p.window != nil {
+		switch ast.Node(cast) {
+		case p.window.StartOffset:
+			return "frame_start"
+		case p.window.EndOffset:
+			return "frame_end"
+		}
+	}
+	call := p.call
+	if call == nil || call.Func == nil || call.Args == nil || len(call.Args.Items) != 3 {
+		return ""
+	}
+	switch call.Func.Name {
+	case "date_add", "date_sub", "adddate", "subdate":
+	default:
+		return ""
+	}
+	if call.Args.Items[1] != ast.Node(cast) {
+		return ""
+	}
+	unit, ok := call.Args.Items[2].(*ast.A_Const)
+	if !ok {
+		return ""
+	}
+	str, ok := unit.Val.(*ast.String)
+	if !ok {
+		return ""
+	}
+	return "interval_" + strings.ToLower(str.Str)
+}
+
 func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	switch n := node.(type) {
 
 	case *ast.A_Expr:
+		// A prefix operator such as -? takes its type from the enclosing
+		// expression
+		if n.Lexpr != nil {
+			p.parent = node
+		}
+
+	case *ast.BetweenExpr:
 		p.parent = node
 
 	case *ast.FuncCall:
 		p.parent = node
+		p.call = n
 
 	case *ast.InsertStmt:
 		if s, ok := n.SelectStmt.(*ast.SelectStmt); ok {
@@ -90,6 +168,9 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 			}
 		}
 
+	case *ast.NullTest:
+		p.parent = node
+
 	case *ast.RangeVar:
 		p.rangeVar = n
 
@@ -97,16 +178,38 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		p.parent = node
 
 	case *ast.SelectStmt:
+		if n.FromClause != nil && len(n.FromClause.Items) > 0 {
+			p.scope = fromRangeVars(n.FromClause.Items)
+		}
 		if n.LimitCount != nil {
 			p.limitCount = n.LimitCount
 		}
 		if n.LimitOffset != nil {
 			p.limitOffset = n.LimitOffset
 		}
+		if n.HavingClause != nil {
+			having := p
+			having.parent = nil
+			having.having = true
+			astutils.Walk(having, n.HavingClause)
+		}
+
+	case *ast.DeleteStmt:
+		if n.LimitCount != nil {
+			p.limitCount = n.LimitCount
+		}
+
+	case *ast.UpdateStmt:
+		if n.LimitCount != nil {
+			p.limitCount = n.LimitCount
+		}
 
 	case *ast.TypeCast:
 		p.parent = node
 
+	case *ast.WindowDef:
+		p.window = n
+
 	case *ast.ParamRef:
 		parent := p.parent
 		//占位符号
@@ -124,6 +227,18 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		if _, found := p.seen[n.Location]; found {
 			break
 		}
+		if cast, ok := parent.(*ast.TypeCast); ok {
+			if name := p.castName(cast); name != "" {
+				parent = &castRef{cast: cast, name: name}
+			}
+		}
+		if expr, ok := parent.(*ast.A_Expr); ok && p.having {
+			if call, ok := expr.Lexpr.(*ast.FuncCall); ok {
+				parent = &aggregateRef{call: call}
+			} else if call, ok := expr.Rexpr.(*ast.FuncCall); ok {
+				parent = &aggregateRef{call: call}
+			}
+		}
 
 		// Special, terrible case for *ast.MultiAssignRef
 		set := true
@@ -143,7 +258,7 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		}
 
 		if set {
-			*p.refs = append(*p.refs, paramRef{parent: parent, ref: n, rv: p.rangeVar})
+			*p.refs = append(*p.refs, paramRef{parent: parent, ref: n, rv: p.rangeVar, scope: p.scope})
 			p.seen[n.Location] = struct{}{}
 		}
 		return nil
@@ -168,3 +283,25 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	}
 	return p
 }
+
+// fromRangeVars returns the tables of a FROM clause, including joined tables
+// but not the tables of subqueries.
+func fromRangeVars(items []ast.Node) []*ast.RangeVar {
+	var rvs []*ast.RangeVar
+	for _, item := range items {
+		switch n := item.(type) {
+		case *ast.RangeVar:
+			rvs = append(rvs, n)
+		case *ast.JoinExpr:
+			rvs = append(rvs, fromRangeVars([]ast.Node{n.Larg, n.Rarg})...)
+		case *ast.List:
+			rvs = append(rvs, fromRangeVars(n.Items)...)
+		case *ast.RangeSubselect:
+			// A derived table is in scope under its alias
+			if n.Alias != nil && n.Alias.Aliasname != nil {
+				rvs = append(rvs, &ast.RangeVar{Relname: n.Alias.Aliasname})
+			}
+		}
+	}
+	return rvs
+}
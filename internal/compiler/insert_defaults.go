@@ -0,0 +1,82 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xiazemin/sqlc/internal/source"
+	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/catalog"
+)
+
+func defaultMarker(i int) string {
+	return fmt.Sprintf("/* sqlc.default:%d */ ?", i+1)
+}
+
+// findInsertDefaults finds the parameters of a single row INSERT ... VALUES
+// that set a NOT NULL column with a default. Each one becomes optional: when
+// it is unset, its placeholder is replaced by DEFAULT. Nullable columns are
+// left alone, as an unset parameter could not be told apart from NULL. The placeholders are marked
+// by wrapping the edits already made at their location, if any.
+func findInsertDefaults(c *catalog.Catalog, stmt ast.Node, refs []paramRef, edits []source.Edit, offset int) ([]Optional, []source.Edit) {
+	insert, ok := stmt.(*ast.InsertStmt)
+	if !ok || insert.Relation == nil || insert.Relation.Relname == nil || insert.Cols == nil {
+		return nil, edits
+	}
+	sel, ok := insert.SelectStmt.(*ast.SelectStmt)
+	if !ok || sel.ValuesLists == nil || len(sel.ValuesLists.Items) != 1 {
+		return nil, edits
+	}
+	row, ok := sel.ValuesLists.Items[0].(*ast.List)
+	if !ok {
+		return nil, edits
+	}
+	rel := &ast.TableName{Name: *insert.Relation.Relname}
+	if insert.Relation.Schemaname != nil {
+		rel.Schema = *insert.Relation.Schemaname
+	}
+	table, err := c.GetTable(rel)
+	if err != nil {
+		return nil, edits
+	}
+	hasDefault := map[string]bool{}
+	for _, col := range table.Columns {
+		if (col.Default != "" || col.IsAutoIncrement) && col.IsNotNull && col.Generated == "" {
+			hasDefault[col.Name] = true
+		}
+	}
+	uses := map[int]int{}
+	for _, r := range refs {
+		uses[r.ref.Number]++
+	}
+
+	var defaults []Optional
+	for i, item := range row.Items {
+		ref, ok := item.(*ast.ParamRef)
+		if !ok || i >= len(insert.Cols.Items) || uses[ref.Number] != 1 {
+			continue
+		}
+		res, ok := insert.Cols.Items[i].(*ast.ResTarget)
+		if !ok || res.Name == nil || !hasDefault[*res.Name] {
+			continue
+		}
+		marker := defaultMarker(len(defaults))
+		loc := ref.Location - offset
+		wrapped := false
+		for j := range edits {
+			if edits[j].Location == loc {
+				edits[j].New = strings.TrimSuffix(marker, "?") + edits[j].New
+				wrapped = true
+			}
+		}
+		if !wrapped {
+			edits = append(edits, source.Edit{Location: loc, Old: "?", New: marker})
+		}
+		defaults = append(defaults, Optional{
+			Param:       ref.Number,
+			Fragment:    marker,
+			Replacement: "DEFAULT",
+		})
+	}
+	return defaults, edits
+}
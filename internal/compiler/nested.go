@@ -0,0 +1,74 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Nested describes a :nested query. Its result columns are a parent table
+// followed by a child table, both selected with sqlc.embed. Rows sharing the
+// primary key of the parent are folded into one result holding the children.
+type Nested struct {
+	Key      []int // parent primary key columns, as indexes into Query.Columns
+	Child    int   // index of the first column of the child table
+	ChildKey int   // child primary key column, NULL when an outer join has no match
+}
+
+// nested checks the columns of a :nested query and locates the keys used to
+// group its rows.
+func nested(qc *QueryCatalog, cols []*Column) (*Nested, error) {
+	var groups []int
+	for i, c := range cols {
+		if c.EmbedTable == nil {
+			return nil, fmt.Errorf(":nested: column %q is not part of a sqlc.embed() table", c.Name)
+		}
+		if i == 0 || c.EmbedTable != cols[i-1].EmbedTable {
+			groups = append(groups, i)
+		}
+	}
+	if len(groups) != 2 {
+		return nil, errors.New(":nested queries must select two sqlc.embed() tables, the parent followed by the child")
+	}
+	if cols[0].EmbedNullable {
+		return nil, fmt.Errorf(":nested: the parent table %q is on the nullable side of an outer join", cols[0].Scope)
+	}
+	n := &Nested{Child: groups[1]}
+
+	key, err := primaryKey(qc, cols[:n.Child])
+	if err != nil {
+		return nil, err
+	}
+	n.Key = key
+	childKey, err := primaryKey(qc, cols[n.Child:])
+	if err != nil {
+		return nil, err
+	}
+	n.ChildKey = n.Child + childKey[0]
+	return n, nil
+}
+
+// primaryKey returns the indexes of the primary key columns of the embedded
+// table holding cols.
+func primaryKey(qc *QueryCatalog, cols []*Column) ([]int, error) {
+	rel := cols[0].EmbedTable
+	table, err := qc.catalog.GetTable(rel)
+	if err != nil {
+		return nil, err
+	}
+	if len(table.PrimaryKey) == 0 {
+		return nil, fmt.Errorf(":nested: table %q has no primary key", rel.Name)
+	}
+	var key []int
+	for _, name := range table.PrimaryKey {
+		for i, c := range cols {
+			if c.Name == name {
+				key = append(key, i)
+				break
+			}
+		}
+	}
+	if len(key) != len(table.PrimaryKey) {
+		return nil, fmt.Errorf(":nested: the primary key of table %q is not selected", rel.Name)
+	}
+	return key, nil
+}
@@ -0,0 +1,26 @@
+package compiler
+
+import "regexp"
+
+var notNullCast = regexp.MustCompile(`(?i)sqlc\.arg\s*\(\s*(?:'\w+'|\w+)\s*\)(\s*::\s*not\s+null\b)`)
+
+// blankNotNullCasts replaces the ::not null suffix of sqlc.arg calls with
+// spaces, as neither engine can parse it. The returned map holds, for every
+// call that had the suffix, its location and the length of the blanked text.
+func blankNotNullCasts(src string) (string, map[int]int) {
+	matches := notNullCast.FindAllStringSubmatchIndex(src, -1)
+	if len(matches) == 0 {
+		return src, nil
+	}
+	b := []byte(src)
+	notNull := map[int]int{}
+	for _, m := range matches {
+		for i := m[2]; i < m[3]; i++ {
+			if b[i] != '\n' && b[i] != '\r' {
+				b[i] = ' '
+			}
+		}
+		notNull[m[0]] = m[3] - m[2]
+	}
+	return string(b), notNull
+}
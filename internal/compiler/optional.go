@@ -0,0 +1,196 @@
+package compiler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/xiazemin/sqlc/internal/config"
+	"github.com/xiazemin/sqlc/internal/source"
+	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
+)
+
+// Optional describes a sqlc.optional(pred) call. The predicate is dropped from
+// the query, together with its arguments, when its parameter is unset.
+type Optional struct {
+	Param       int    // number of the parameter controlling the predicate
+	Fragment    string // predicate text in Query.SQL
+	Replacement string // text replacing Fragment when the parameter is unset
+
+	// KeepArgs is set when the arguments are passed even if the parameter is
+	// unset. Numbered placeholders can't be dropped without renumbering the
+	// ones that follow, so the predicate is short-circuited instead.
+	KeepArgs bool
+}
+
+var optionalCall = regexp.MustCompile(`sqlc\.optional\s*\(`)
+
+type optionalSpan struct {
+	start, end int // offsets of the call in the raw query, end exclusive
+	param      int
+}
+
+func optionalMarker(i int) string {
+	return fmt.Sprintf("/* sqlc.optional:%d */ (", i+1)
+}
+
+// closingParen returns the offset just past the parenthesis closing the one
+// opened right before start, skipping quoted strings and identifiers.
+func closingParen(s string, start int) int {
+	depth := 1
+	var quote byte
+	for i := start; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return -1
+}
+
+// findOptionals locates the sqlc.optional calls of a query and assigns every
+// parameter reference to the call containing it. Each call must reference
+// exactly one parameter, and that parameter may not be used anywhere else.
+func findOptionals(engine config.Engine, refs []paramRef, rawSQL string, offset int) ([]optionalSpan, []source.Edit, error) {
+	matches := optionalCall.FindAllStringIndex(rawSQL, -1)
+	if len(matches) == 0 {
+		return nil, nil, nil
+	}
+	if engine != config.EngineMySQL && engine != config.EnginePostgreSQL {
+		return nil, nil, &sqlerr.Error{
+			Message:  fmt.Sprintf("sqlc.optional is not supported by the %s engine", engine),
+			Location: offset + matches[0][0],
+		}
+	}
+
+	var spans []optionalSpan
+	var edits []source.Edit
+	for i, m := range matches {
+		end := closingParen(rawSQL, m[1])
+		if end < 0 {
+			return nil, nil, &sqlerr.Error{
+				Message:  "sqlc.optional: missing closing parenthesis",
+				Location: offset + m[0],
+			}
+		}
+		span := optionalSpan{start: m[0], end: end}
+		for _, r := range refs {
+			loc := r.ref.Location - offset
+			if loc < span.start || loc >= span.end {
+				continue
+			}
+			if span.param != 0 && span.param != r.ref.Number {
+				return nil, nil, &sqlerr.Error{
+					Message:  "sqlc.optional: predicate must reference exactly one parameter",
+					Location: offset + m[0],
+				}
+			}
+			span.param = r.ref.Number
+		}
+		if span.param == 0 {
+			return nil, nil, &sqlerr.Error{
+				Message:  "sqlc.optional: predicate does not reference a parameter",
+				Location: offset + m[0],
+			}
+		}
+		for _, prev := range spans {
+			if span.start < prev.end {
+				return nil, nil, &sqlerr.Error{
+					Message:  "sqlc.optional: calls may not be nested",
+					Location: offset + m[0],
+				}
+			}
+		}
+		spans = append(spans, span)
+		edits = append(edits, source.Edit{
+			Location: m[0],
+			Old:      rawSQL[m[0]:m[1]],
+			New:      optionalMarker(i),
+		})
+	}
+
+	for _, r := range refs {
+		loc := r.ref.Location - offset
+		inside := false
+		optional := false
+		for _, span := range spans {
+			if span.param == r.ref.Number {
+				optional = true
+			}
+			if loc >= span.start && loc < span.end {
+				inside = true
+			}
+		}
+		if optional && !inside {
+			return nil, nil, &sqlerr.Error{
+				Message:  "sqlc.optional: parameter is also used outside of its predicate",
+				Location: r.ref.Location,
+			}
+		}
+	}
+	seen := map[int]struct{}{}
+	for _, span := range spans {
+		if _, ok := seen[span.param]; ok {
+			return nil, nil, &sqlerr.Error{
+				Message:  "sqlc.optional: parameter is used by more than one predicate",
+				Location: offset + span.start,
+			}
+		}
+		seen[span.param] = struct{}{}
+	}
+	return spans, edits, nil
+}
+
+// resolveOptionals marks the parameters of optional predicates as nullable
+// and extracts the final predicate text from the rewritten query. MySQL drops
+// an unset predicate; PostgreSQL keeps its placeholder, and so the type of its
+// parameter, behind a TRUE OR.
+func resolveOptionals(engine config.Engine, spans []optionalSpan, params []Parameter, sql string) ([]Optional, error) {
+	var optionals []Optional
+	for i, span := range spans {
+		for j := range params {
+			if params[j].Number != span.param {
+				continue
+			}
+			if params[j].Column == nil {
+				params[j].Column = &Column{DataType: "any"}
+			}
+			if params[j].Column.IsSlice {
+				return nil, fmt.Errorf("sqlc.optional: slice parameters are not supported")
+			}
+			params[j].Column.NotNull = false
+		}
+		marker := optionalMarker(i)
+		start := strings.Index(sql, marker)
+		if start < 0 {
+			return nil, fmt.Errorf("sqlc.optional: predicate %d not found in rewritten query", i+1)
+		}
+		end := closingParen(sql, start+len(marker))
+		if end < 0 {
+			return nil, fmt.Errorf("sqlc.optional: predicate %d is not terminated", i+1)
+		}
+		o := Optional{
+			Param:       span.param,
+			Fragment:    sql[start:end],
+			Replacement: "TRUE",
+		}
+		if engine == config.EnginePostgreSQL {
+			o.Replacement = "(TRUE OR " + sql[start+len(marker):end]
+			o.KeepArgs = true
+		}
+		optionals = append(optionals, o)
+	}
+	return optionals, nil
+}
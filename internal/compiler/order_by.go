@@ -0,0 +1,130 @@
+package compiler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/xiazemin/sqlc/internal/source"
+	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
+)
+
+// OrderBy describes a sqlc.order_by('name', allowed: a, b) call. The caller
+// picks one of the allowed columns and a direction at run time; the query text
+// only ever receives one of the fragments known at compile time.
+type OrderBy struct {
+	Name    string   // name of the generated method argument
+	Columns []string // allowed sort columns, in declaration order
+	Marker  string   // fragment of Query.SQL replaced by the chosen ordering
+}
+
+// Fragment returns the ORDER BY fragment for column in the given direction.
+func (o *OrderBy) Fragment(column string, desc bool) string {
+	if desc {
+		return column + " DESC"
+	}
+	return column + " ASC"
+}
+
+var (
+	orderByLabel = regexp.MustCompile(`sqlc\.order_by\s*\(\s*'\w+'\s*,\s*(allowed\s*:)`)
+	orderByCall  = regexp.MustCompile(`sqlc\.order_by\s*\(\s*'(\w+)'\s*,([^)]*)\)`)
+	orderByIdent = regexp.MustCompile(`^\w+(\.\w+)?$`)
+)
+
+// blankOrderByLabels replaces the "allowed:" label of sqlc.order_by calls with
+// spaces. The call then parses as a regular function call, and the locations
+// of every node in the file stay the same.
+func blankOrderByLabels(src string) string {
+	matches := orderByLabel.FindAllStringSubmatchIndex(src, -1)
+	if len(matches) == 0 {
+		return src
+	}
+	b := []byte(src)
+	for _, m := range matches {
+		for i := m[2]; i < m[3]; i++ {
+			if b[i] != '\n' && b[i] != '\r' {
+				b[i] = ' '
+			}
+		}
+	}
+	return string(b)
+}
+
+// resolveOrderBy finds the sqlc.order_by call in a query, checks the allowed
+// columns against the tables and output columns of the query, and returns an
+// edit replacing the call with the default ordering.
+func resolveOrderBy(qc *QueryCatalog, rvs []*ast.RangeVar, cols []*Column, rawSQL string, offset int) (*OrderBy, []source.Edit, error) {
+	matches := orderByCall.FindAllStringSubmatchIndex(rawSQL, -1)
+	if len(matches) == 0 {
+		return nil, nil, nil
+	}
+	if len(matches) > 1 {
+		return nil, nil, &sqlerr.Error{
+			Message:  "only one sqlc.order_by is allowed per query",
+			Location: offset + matches[1][0],
+		}
+	}
+	m := matches[0]
+	loc := offset + m[0]
+
+	known := map[string]struct{}{}
+	for _, c := range cols {
+		known[c.Name] = struct{}{}
+	}
+	for _, rv := range rvs {
+		if rv.Relname == nil {
+			continue
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			return nil, nil, err
+		}
+		table, err := qc.GetTable(fqn)
+		if err != nil {
+			continue
+		}
+		for _, c := range table.Columns {
+			known[c.Name] = struct{}{}
+		}
+	}
+
+	o := &OrderBy{Name: rawSQL[m[2]:m[3]]}
+	seen := map[string]struct{}{}
+	for _, item := range strings.Split(rawSQL[m[4]:m[5]], ",") {
+		column := strings.TrimSpace(item)
+		if !orderByIdent.MatchString(column) {
+			return nil, nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("sqlc.order_by: %q is not a column reference", column),
+				Location: loc,
+			}
+		}
+		name := column
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+		if _, ok := known[name]; !ok {
+			return nil, nil, &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("sqlc.order_by: column %q does not exist", name),
+				Location: loc,
+			}
+		}
+		if _, ok := seen[column]; ok {
+			return nil, nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("sqlc.order_by: column %q listed more than once", column),
+				Location: loc,
+			}
+		}
+		seen[column] = struct{}{}
+		o.Columns = append(o.Columns, column)
+	}
+	o.Marker = fmt.Sprintf("/* sqlc.order_by:%s */ %s", o.Name, o.Fragment(o.Columns[0], false))
+
+	return o, []source.Edit{{
+		Location: m[0],
+		Old:      rawSQL[m[0]:m[1]],
+		New:      o.Marker,
+	}}, nil
+}
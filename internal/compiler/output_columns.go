@@ -9,7 +9,6 @@ import (
 	"github.com/xiazemin/sqlc/internal/sql/catalog"
 	"github.com/xiazemin/sqlc/internal/sql/lang"
 	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
-	"github.com/xiazemin/sqlc/internal/util"
 )
 
 func hasStarRef(cf *ast.ColumnRef) bool {
@@ -21,11 +20,43 @@ func hasStarRef(cf *ast.ColumnRef) bool {
 	return false
 }
 
+// OutputColumns computes the columns of a view's query, as catalog columns.
+func (c *Compiler) OutputColumns(stmt ast.Node) ([]*catalog.Column, error) {
+	qc, err := buildQueryCatalog(c.catalog, stmt)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := outputColumns(qc, stmt)
+	if err != nil {
+		return nil, err
+	}
+	return catalogColumns(cols), nil
+}
+
+func catalogColumns(cols []*Column) []*catalog.Column {
+	catCols := make([]*catalog.Column, 0, len(cols))
+	for _, col := range cols {
+		catCols = append(catCols, &catalog.Column{
+			Name:       col.Name,
+			Type:       ast.TypeName{Name: col.DataType},
+			IsNotNull:  col.NotNull,
+			IsArray:    col.IsArray,
+			IsUnsigned: col.Unsigned,
+			Comment:    col.Comment,
+			Length:     col.Length,
+		})
+	}
+	return catCols
+}
+
 // Compute the output columns for a statement.
 //
 // Return an error if column references are ambiguous
 // Return an error if column references don't exist
 func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
+	if n, ok := node.(*ast.SelectStmt); ok && n.Larg != nil && (n.TargetList == nil || len(n.TargetList.Items) == 0) {
+		return setOperationColumns(qc, n)
+	}
 	tables, err := sourceTables(qc, node)
 	if err != nil {
 		return nil, err
@@ -39,6 +70,11 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 		targets = n.ReturningList
 	case *ast.SelectStmt:
 		targets = n.TargetList
+		if err := validateSortRefs(n, tables); err != nil {
+			return nil, err
+		}
+	case *ast.RefreshMatViewStmt:
+		targets = &ast.List{}
 	case *ast.TruncateStmt:
 		targets = &ast.List{}
 	case *ast.UpdateStmt:
@@ -93,30 +129,20 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 				col.Name = name
 				cols = append(cols, col)
 			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+				col, err := caseColumn(qc, res, tables, n)
+				if err != nil {
+					return nil, err
+				}
+				col.Name = name
+				cols = append(cols, col)
 			}
 
 		case *ast.CoalesceExpr:
-			var found bool
-			for _, arg := range n.Args.Items {
-				if found {
-					continue
-				}
-				if ref, ok := arg.(*ast.ColumnRef); ok {
-					columns, err := outputColumnRefs(res, tables, ref)
-					if err != nil {
-						return nil, err
-					}
-					for _, c := range columns {
-						found = true
-						c.NotNull = true
-						cols = append(cols, c)
-					}
-				}
-			}
-			if !found {
-				cols = append(cols, &Column{Name: "coalesce", DataType: "any", NotNull: false})
+			col, err := coalesceColumn(qc, res, tables, n)
+			if err != nil {
+				return nil, err
 			}
+			cols = append(cols, col)
 
 		case *ast.ColumnRef:
 			if hasStarRef(n) {
@@ -134,11 +160,13 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 						cols = append(cols, &Column{
 							Name:     cname,
 							Type:     c.Type,
-							Scope:    scope,
+							Scope:    t.Rel.Name,
 							Table:    c.Table,
 							DataType: c.DataType,
 							NotNull:  c.NotNull,
 							IsArray:  c.IsArray,
+							Unsigned: c.Unsigned,
+							Length:   c.Length,
 						})
 					}
 				}
@@ -152,178 +180,31 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			cols = append(cols, columns...)
 
 		case *ast.FuncCall:
-			//这里解析函数调用
-			util.Xiazeminlog("ast.FuncCall", n, false)
-			util.Xiazeminlog("ast.FuncCall tables ", tables, false)
-			/*
-				{
-					"Func": {
-						"Catalog": "",
-						"Schema": "",
-						"Name": "ifnull"
-					},
-					"Funcname": {
-						"Items": [
-							{
-								"Str": "ifnull"
-							}
-						]
-					},
-					"Args": {
-						"Items": [
-							{
-								"Func": {
-									"Catalog": "",
-									"Schema": "",
-									"Name": "sum"
-								},
-								"Funcname": {
-									"Items": [
-										{
-											"Str": "sum"
-										}
-									]
-								},
-								"Args": {
-									"Items": [
-										{
-											"Name": "",
-											"Fields": {
-												"Items": [
-													{
-														"Str": "size"
-													}
-												]
-											},
-											"Location": 0,
-											"TableName": ""
-										}
-									]
-								},
-								"AggOrder": {
-									"Items": null
-								},
-								"AggFilter": null,
-								"AggWithinGroup": false,
-								"AggStar": false,
-								"AggDistinct": false,
-								"FuncVariadic": false,
-								"Over": null,
-								"Location": 0
-							},
-							{
-								"Val": {
-									"Str": ""
-								},
-								"Location": 0
-							}
-						]
-					},
-					"AggOrder": null,
-					"AggFilter": null,
-					"AggWithinGroup": false,
-					"AggStar": false,
-					"AggDistinct": false,
-					"FuncVariadic": false,
-					"Over": null,
-					"Location": 981
+			if isEmbed(n) {
+				columns, err := embedColumns(qc, tables, n)
+				if err != nil {
+					return nil, err
 				}
-			*/
-			rel := n.Func
-			name := rel.Name //这里直接用方法的名字也行，和sql保持一致
+				cols = append(cols, columns...)
+				continue
+			}
+			name := n.Func.Name //这里直接用方法的名字也行，和sql保持一致
 			if res.Name != nil {
 				name = *res.Name
 			}
-			var tablse1 []*catalog.Table
-			for _, tab := range tables {
-				var col []*catalog.Column
-				for _, c := range tab.Columns {
-					col = append(col, &catalog.Column{
-						Name: c.Name,
-						Type: ast.TypeName{
-							Name: c.DataType,
-						},
-						IsNotNull: c.NotNull,
-						IsArray:   c.IsArray,
-						Comment:   c.Comment,
-						Length:    c.Length,
-					})
-				}
-
-				tablse1 = append(tablse1, &catalog.Table{
-					Rel:     tab.Rel,
-					Columns: col,
-				})
+			col, err := funcColumn(qc, res, tables, n)
+			if err != nil {
+				return nil, err
 			}
+			col.Name = name
+			cols = append(cols, col)
 
-			fun, notNull, err := qc.catalog.ResolveFuncCall(n, tablse1)
-			util.Xiazeminlog("ResolveFuncCall", fun, false)
-			util.Xiazeminlog("ResolveFuncCall", notNull, false)
-			/*
-				{
-					"Name": "IFNULL",
-					"Args": [
-						{
-							"Name": "",
-							"Type": {
-								"Catalog": "",
-								"Schema": "",
-								"Name": "any",
-								"Names": null,
-								"TypeOid": 0,
-								"Setof": false,
-								"PctType": false,
-								"Typmods": null,
-								"Typemod": 0,
-								"ArrayBounds": null,
-								"Location": 0
-							},
-							"HasDefault": false,
-							"Mode": 0
-						},
-						{
-							"Name": "",
-							"Type": {
-								"Catalog": "",
-								"Schema": "",
-								"Name": "bigint",
-								"Names": null,
-								"TypeOid": 0,
-								"Setof": false,
-								"PctType": false,
-								"Typmods": null,
-								"Typemod": 0,
-								"ArrayBounds": null,
-								"Location": 0
-							},
-							"HasDefault": false,
-							"Mode": 0
-						}
-					],
-					"ReturnType": {
-						"Catalog": "",
-						"Schema": "",
-						"Name": "bigint",
-						"Names": null,
-						"TypeOid": 0,
-						"Setof": false,
-						"PctType": false,
-						"Typmods": null,
-						"Typemod": 0,
-						"ArrayBounds": null,
-						"Location": 0
-					},
-					"Comment": "",
-					"Desc": ""
-				}
-			*/
-			util.Xiazeminlog("\n \n ResolveFuncCall name ", []string{name, rel.Name}, false)
-			if err == nil {
-				//这里的NotNull 不能直接为true，否则会报0 sql: Scan error on column index 0, name "sum(size)": converting NULL to int64 is unsupported
-				cols = append(cols, &Column{Name: name, DataType: dataType(fun.ReturnType), NotNull: notNull})
-			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any"})
+		case *ast.NullTest:
+			name := ""
+			if res.Name != nil {
+				name = *res.Name
 			}
+			cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
 
 		case *ast.SubLink:
 			name := "exists"
@@ -349,7 +230,10 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 				name = *res.Name
 			}
 			// TODO Validate column names
-			col := toColumn(n.TypeName)
+			col, err := castColumn(qc, res, tables, n)
+			if err != nil {
+				return nil, err
+			}
 			col.Name = name
 			cols = append(cols, col)
 
@@ -373,6 +257,7 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 // Return an error if an unknown column is referenced
 func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	var list *ast.List
+	nullable := map[ast.Node]bool{}
 	switch n := node.(type) {
 	case *ast.DeleteStmt:
 		list = &ast.List{
@@ -383,14 +268,12 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 			Items: []ast.Node{n.Relation},
 		}
 	case *ast.SelectStmt:
-		list = astutils.Search(n.FromClause, func(node ast.Node) bool {
-			switch node.(type) {
-			case *ast.RangeVar, *ast.RangeSubselect:
-				return true
-			default:
-				return false
-			}
-		})
+		list = &ast.List{Items: fromTables(n.FromClause)}
+		if n.FromClause != nil {
+			outerJoinTables(n.FromClause, false, nullable)
+		}
+	case *ast.RefreshMatViewStmt:
+		list = &ast.List{Items: []ast.Node{n.Relation}}
 	case *ast.TruncateStmt:
 		list = astutils.Search(n.Relations, func(node ast.Node) bool {
 			_, ok := node.(*ast.RangeVar)
@@ -408,16 +291,14 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	for _, item := range list.Items {
 		switch n := item.(type) {
 		case *ast.RangeSubselect:
-			cols, err := outputColumns(qc, n.Subquery)
+			table, err := subselectTable(qc, n)
 			if err != nil {
 				return nil, err
 			}
-			tables = append(tables, &Table{
-				Rel: &ast.TableName{
-					Name: *n.Alias.Aliasname,
-				},
-				Columns: cols,
-			})
+			if nullable[n] {
+				table = nullableTable(table)
+			}
+			tables = append(tables, table)
 
 		case *ast.RangeVar:
 			fqn, err := ParseTableName(n)
@@ -438,6 +319,9 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 					Name:    *n.Alias.Aliasname,
 				}
 			}
+			if nullable[n] {
+				table = nullableTable(table)
+			}
 			tables = append(tables, table)
 		default:
 			return nil, fmt.Errorf("sourceTable: unsupported list item type: %T", n)
@@ -446,6 +330,211 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	return tables, nil
 }
 
+// fromTables returns the relations of a FROM clause. The tables read by a
+// subquery in FROM belong to the subquery, not to the enclosing query.
+func fromTables(node ast.Node) []ast.Node {
+	var items []ast.Node
+	switch n := node.(type) {
+	case *ast.List:
+		if n == nil {
+			return nil
+		}
+		for _, item := range n.Items {
+			items = append(items, fromTables(item)...)
+		}
+	case *ast.JoinExpr:
+		items = append(items, fromTables(n.Larg)...)
+		items = append(items, fromTables(n.Rarg)...)
+	case *ast.RangeVar, *ast.RangeSubselect:
+		items = append(items, n)
+	}
+	return items
+}
+
+// subselectTable returns the derived table of a subquery in a FROM clause. Its
+// columns are those of the subquery, renamed by the alias column list if any.
+func subselectTable(qc *QueryCatalog, n *ast.RangeSubselect) (*Table, error) {
+	cols, err := outputColumns(qc, n.Subquery)
+	if err != nil {
+		return nil, err
+	}
+	rel := &ast.TableName{}
+	if n.Alias != nil && n.Alias.Aliasname != nil {
+		rel.Name = *n.Alias.Aliasname
+	}
+	if n.Alias != nil && n.Alias.Colnames != nil && len(n.Alias.Colnames.Items) > 0 {
+		names := n.Alias.Colnames.Items
+		if len(names) > len(cols) {
+			return nil, &sqlerr.Error{
+				Code:    "42P10",
+				Message: fmt.Sprintf("table \"%s\" has %d columns available but %d columns specified", rel.Name, len(cols), len(names)),
+			}
+		}
+		for i, item := range names {
+			if name, ok := item.(*ast.String); ok {
+				cols[i].Name = name.Str
+			}
+		}
+	}
+	return &Table{Rel: rel, Columns: cols}, nil
+}
+
+// outerJoinTables records the tables of a FROM clause that are on the
+// nullable side of an outer join: the right side of a LEFT JOIN, the left
+// side of a RIGHT JOIN and both sides of a FULL JOIN. Their columns are NULL
+// for rows without a match, whatever the catalog says.
+func outerJoinTables(node ast.Node, outer bool, nullable map[ast.Node]bool) {
+	switch n := node.(type) {
+	case *ast.List:
+		for _, item := range n.Items {
+			outerJoinTables(item, outer, nullable)
+		}
+	case *ast.JoinExpr:
+		left := n.Jointype == ast.JoinTypeRight || n.Jointype == ast.JoinTypeFull
+		right := n.Jointype == ast.JoinTypeLeft || n.Jointype == ast.JoinTypeFull
+		outerJoinTables(n.Larg, outer || left, nullable)
+		outerJoinTables(n.Rarg, outer || right, nullable)
+	case *ast.RangeVar, *ast.RangeSubselect:
+		if outer {
+			nullable[n] = true
+		}
+	}
+}
+
+// nullableTable returns a copy of the table whose columns may all be NULL.
+func nullableTable(t *Table) *Table {
+	cols := make([]*Column, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		col := *c
+		col.NotNull = false
+		cols = append(cols, &col)
+	}
+	return &Table{Rel: t.Rel, Columns: cols, Nullable: true}
+}
+
+// setOperationColumns returns the output columns of a UNION, INTERSECT or
+// EXCEPT query. Columns are named and typed after the left branch; their
+// nullability depends on both branches.
+func setOperationColumns(qc *QueryCatalog, n *ast.SelectStmt) ([]*Column, error) {
+	left, err := outputColumns(qc, n.Larg)
+	if err != nil {
+		return nil, err
+	}
+	right, err := outputColumns(qc, n.Rarg)
+	if err != nil {
+		return nil, err
+	}
+	if len(left) != len(right) {
+		return nil, errors.New("each query of a set operation must have the same number of columns")
+	}
+	cols := make([]*Column, len(left))
+	for i, l := range left {
+		col := *l
+		switch n.Op {
+		case ast.Union:
+			col.NotNull = l.NotNull && right[i].NotNull
+		case ast.Intersect:
+			col.NotNull = l.NotNull || right[i].NotNull
+		}
+		cols[i] = &col
+	}
+	return cols, nil
+}
+
+// validateSortRefs checks that the columns referenced by the GROUP BY and
+// ORDER BY clauses of a query exist. A bare name may also refer to an output
+// column alias.
+func validateSortRefs(n *ast.SelectStmt, tables []*Table) error {
+	if n.TargetList == nil || len(n.TargetList.Items) == 0 {
+		return nil
+	}
+	// The columns of table functions are unknown
+	functions := astutils.Search(n.FromClause, func(node ast.Node) bool {
+		_, ok := node.(*ast.RangeFunction)
+		return ok
+	})
+	if len(functions.Items) > 0 {
+		return nil
+	}
+	var items []ast.Node
+	if n.GroupClause != nil {
+		items = append(items, n.GroupClause.Items...)
+	}
+	if n.SortClause != nil {
+		for _, item := range n.SortClause.Items {
+			if sb, ok := item.(*ast.SortBy); ok {
+				items = append(items, sb.Node)
+			}
+		}
+	}
+	for _, item := range items {
+		ref, ok := item.(*ast.ColumnRef)
+		if !ok || hasStarRef(ref) {
+			continue
+		}
+		if err := findColumnForRef(ref, tables, n.TargetList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findColumnForRef(ref *ast.ColumnRef, tables []*Table, targets *ast.List) error {
+	parts := stringSlice(ref.Fields)
+	var name, alias string
+	switch len(parts) {
+	case 1:
+		name = parts[0]
+	case 2:
+		alias = parts[0]
+		name = parts[1]
+	default:
+		return nil
+	}
+	if alias == "" {
+		for _, target := range targets.Items {
+			res, ok := target.(*ast.ResTarget)
+			if !ok {
+				continue
+			}
+			if res.Name != nil && *res.Name == name {
+				return nil
+			}
+			if col, ok := res.Val.(*ast.ColumnRef); ok && res.Name == nil {
+				if fields := stringSlice(col.Fields); len(fields) > 0 && fields[len(fields)-1] == name {
+					return nil
+				}
+			}
+		}
+	}
+	var found int
+	for _, t := range tables {
+		if alias != "" && t.Rel.Name != alias {
+			continue
+		}
+		for _, c := range t.Columns {
+			if c.Name == name {
+				found += 1
+			}
+		}
+	}
+	if found == 0 {
+		return &sqlerr.Error{
+			Code:     "42703",
+			Message:  fmt.Sprintf("column reference \"%s\" not found", name),
+			Location: ref.Location,
+		}
+	}
+	if found > 1 {
+		return &sqlerr.Error{
+			Code:     "42703",
+			Message:  fmt.Sprintf("column reference \"%s\" is ambiguous", name),
+			Location: ref.Location,
+		}
+	}
+	return nil
+}
+
 func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef) ([]*Column, error) {
 	parts := stringSlice(node.Fields)
 	var name, alias string
@@ -474,10 +563,13 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 				cols = append(cols, &Column{
 					Name:     cname,
 					Type:     c.Type,
+					Scope:    t.Rel.Name,
 					Table:    c.Table,
 					DataType: c.DataType,
 					NotNull:  c.NotNull,
 					IsArray:  c.IsArray,
+					Unsigned: c.Unsigned,
+					Length:   c.Length,
 				})
 			}
 		}
@@ -498,3 +590,246 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 	}
 	return cols, nil
 }
+
+// caseColumn infers the type of a CASE expression from its THEN and ELSE
+// branches. The first branch with a known type decides the type; the result
+// is nullable if there is no ELSE or if any branch can be NULL.
+func caseColumn(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, n *ast.CaseExpr) (*Column, error) {
+	var branches []ast.Node
+	for _, item := range n.Args.Items {
+		if when, ok := item.(*ast.CaseWhen); ok {
+			branches = append(branches, when.Result)
+		}
+	}
+	notNull := n.Defresult != nil
+	if notNull {
+		branches = append(branches, n.Defresult)
+	}
+	var col *Column
+	for _, branch := range branches {
+		c, err := branchColumn(qc, res, tables, branch)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			notNull = false
+			continue
+		}
+		notNull = notNull && c.NotNull
+		if col == nil {
+			col = c
+		}
+	}
+	if col == nil {
+		return &Column{DataType: "any"}, nil
+	}
+	col.NotNull = notNull
+	return col, nil
+}
+
+// coalesceColumn returns the column of a COALESCE expression. It is typed after
+// its first column or function argument, and is NOT NULL when any of its
+// arguments is, usually the fallback at the end.
+func coalesceColumn(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, n *ast.CoalesceExpr) (*Column, error) {
+	var col *Column
+	var notNull bool
+	for _, arg := range n.Args.Items {
+		c, err := branchColumn(qc, res, tables, arg)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			continue
+		}
+		notNull = notNull || c.NotNull
+		switch arg.(type) {
+		case *ast.ColumnRef, *ast.FuncCall:
+			if col == nil {
+				col = c
+			}
+		}
+	}
+	if col == nil {
+		col = &Column{DataType: "any"}
+	}
+	if col.Name == "" {
+		col.Name = "coalesce"
+		if res.Name != nil {
+			col.Name = *res.Name
+		}
+	}
+	col.NotNull = notNull
+	return col, nil
+}
+
+// funcColumn types a function call from the catalog, without a name. Whether
+// it can be NULL depends on the nullability of the function.
+func funcColumn(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, n *ast.FuncCall) (*Column, error) {
+	var catTables []*catalog.Table
+	for _, tab := range tables {
+		catTables = append(catTables, &catalog.Table{
+			Rel:     tab.Rel,
+			Columns: catalogColumns(tab.Columns),
+		})
+	}
+
+	fun, _, err := qc.catalog.ResolveFuncCall(n, catTables)
+	if err != nil {
+		return &Column{DataType: "any"}, nil
+	}
+	var notNull bool
+	switch fun.ReturnNullability {
+	case catalog.NeverNull:
+		notNull = true
+	case catalog.AlwaysNullable:
+		//这里的NotNull 不能直接为true，否则会报0 sql: Scan error on column index 0, name "sum(size)": converting NULL to int64 is unsupported
+		notNull = false
+	case catalog.NullIfAllArgsNull:
+		// IFNULL(a, b) is COALESCE(a, b), so it is typed the same way
+		return coalesceColumn(qc, res, tables, &ast.CoalesceExpr{Args: n.Args})
+	default:
+		// Arguments of unknown type are treated as constants
+		notNull = true
+		if n.Args != nil {
+			for _, arg := range n.Args.Items {
+				c, err := branchColumn(qc, res, tables, arg)
+				if err != nil {
+					return nil, err
+				}
+				if c != nil && !c.NotNull {
+					notNull = false
+				}
+			}
+		}
+	}
+	return &Column{DataType: dataType(fun.ReturnType), NotNull: notNull}, nil
+}
+
+// branchColumn returns the column for a single CASE branch, or nil if its type
+// is unknown.
+func branchColumn(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, node ast.Node) (*Column, error) {
+	switch n := node.(type) {
+	case *ast.A_Const:
+		switch n.Val.(type) {
+		case *ast.Integer:
+			return &Column{DataType: "int", NotNull: true}, nil
+		case *ast.Float:
+			return &Column{DataType: "double precision", NotNull: true}, nil
+		case *ast.String:
+			return &Column{DataType: "text", NotNull: true}, nil
+		}
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return nil, nil
+		}
+		columns, err := outputColumnRefs(res, tables, n)
+		if err != nil {
+			return nil, err
+		}
+		return columns[0], nil
+	case *ast.FuncCall:
+		if isEmbed(n) {
+			return nil, nil
+		}
+		return funcColumn(qc, res, tables, n)
+	case *ast.TypeCast:
+		if n.TypeName == nil {
+			return nil, errors.New("no type name type cast")
+		}
+		return castColumn(qc, res, tables, n)
+	}
+	return nil, nil
+}
+
+// castColumn types a CAST by its target type. A cast of NULL, or of an
+// argument that can be NULL, is nullable.
+func castColumn(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, n *ast.TypeCast) (*Column, error) {
+	col := toColumn(n.TypeName)
+	if c, ok := n.Arg.(*ast.A_Const); ok {
+		if _, ok := c.Val.(*ast.Null); ok {
+			col.NotNull = false
+		}
+	}
+	arg, err := branchColumn(qc, res, tables, n.Arg)
+	if err != nil {
+		return nil, err
+	}
+	if arg != nil && !arg.NotNull {
+		col.NotNull = false
+	}
+	return col, nil
+}
+
+func isEmbed(n *ast.FuncCall) bool {
+	return n.Func != nil && n.Func.Schema == "sqlc" && n.Func.Name == "embed"
+}
+
+// embedScope returns the table name or alias passed to sqlc.embed.
+func embedScope(n *ast.FuncCall) (string, error) {
+	if n.Args != nil && len(n.Args.Items) == 1 {
+		if ref, ok := n.Args.Items[0].(*ast.ColumnRef); ok && len(ref.Fields.Items) == 1 {
+			if s, ok := ref.Fields.Items[0].(*ast.String); ok {
+				return s.Str, nil
+			}
+		}
+	}
+	return "", &sqlerr.Error{
+		Message:  "sqlc.embed expects a single table name",
+		Location: n.Location,
+	}
+}
+
+func embedTable(tables []*Table, n *ast.FuncCall) (*Table, string, error) {
+	scope, err := embedScope(n)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, t := range tables {
+		if t.Rel.Name == scope {
+			return t, scope, nil
+		}
+	}
+	return nil, "", &sqlerr.Error{
+		Code:     "42P01",
+		Message:  fmt.Sprintf("sqlc.embed: relation \"%s\" is not in the FROM clause", scope),
+		Location: n.Location,
+	}
+}
+
+// embedColumns returns every column of the table passed to sqlc.embed, tagged
+// with the table whose model struct holds them. The columns keep the
+// nullability of the model, even on the nullable side of an outer join.
+func embedColumns(qc *QueryCatalog, tables []*Table, n *ast.FuncCall) ([]*Column, error) {
+	t, scope, err := embedTable(tables, n)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.Columns) == 0 || t.Columns[0].Table == nil {
+		return nil, &sqlerr.Error{
+			Message:  fmt.Sprintf("sqlc.embed: %q is not a table", scope),
+			Location: n.Location,
+		}
+	}
+	embed := *t.Columns[0].Table
+	model, err := qc.GetTable(&embed)
+	if err != nil {
+		return nil, err
+	}
+	var cols []*Column
+	for i, c := range t.Columns {
+		cols = append(cols, &Column{
+			Name:          c.Name,
+			Type:          c.Type,
+			Scope:         scope,
+			Table:         c.Table,
+			DataType:      c.DataType,
+			NotNull:       model.Columns[i].NotNull,
+			IsArray:       c.IsArray,
+			Unsigned:      c.Unsigned,
+			Length:        c.Length,
+			EmbedTable:    &embed,
+			EmbedNullable: t.Nullable,
+		})
+	}
+	return cols, nil
+}
@@ -0,0 +1,342 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xiazemin/sqlc/internal/config"
+	"github.com/xiazemin/sqlc/internal/source"
+	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/astutils"
+)
+
+// Paginate describes the keyset pagination of a :paginate query. Query.SQL
+// fetches the first page, and SQL fetches the page following a cursor.
+type Paginate struct {
+	SQL   string
+	Keys  []PageKey
+	Binds []int // key bound to each cursor placeholder of SQL, in order
+}
+
+// PageKey is one of the ORDER BY columns of a :paginate query.
+type PageKey struct {
+	Column int // index into Query.Columns
+	Desc   bool
+}
+
+// identAt returns the possibly qualified and quoted identifier starting at
+// pos in s.
+func identAt(s string, pos int) string {
+	i := pos
+	for i < len(s) {
+		ch := s[i]
+		switch {
+		case ch == '"' || ch == '`':
+			j := strings.IndexByte(s[i+1:], ch)
+			if j < 0 {
+				return s[pos:]
+			}
+			i += j + 2
+		case isWordByte(ch) || ch == '.' || ch == '$':
+			i++
+		default:
+			return s[pos:i]
+		}
+	}
+	return s[pos:i]
+}
+
+func isWordByte(ch byte) bool {
+	return ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9'
+}
+
+// keywordsBefore matches words, in order, against the text that ends at pos,
+// ignoring whitespace and the characters in skip right before pos. It returns
+// the offset of the first word.
+func keywordsBefore(s string, pos int, skip string, words ...string) (int, bool) {
+	i := pos
+	for i > 0 && strings.IndexByte(" \t\r\n"+skip, s[i-1]) >= 0 {
+		i--
+	}
+	for w := len(words) - 1; w >= 0; w-- {
+		word := words[w]
+		if i < len(word) || !strings.EqualFold(s[i-len(word):i], word) {
+			return 0, false
+		}
+		i -= len(word)
+		if i > 0 && isWordByte(s[i-1]) {
+			return 0, false
+		}
+		if w > 0 {
+			for i > 0 && strings.IndexByte(" \t\r\n", s[i-1]) >= 0 {
+				i--
+			}
+		}
+	}
+	return i, true
+}
+
+// isEmpty reports whether an optional clause is absent. Depending on the
+// engine, an absent clause is nil, a typed nil or a TODO node.
+func isEmpty(n ast.Node) bool {
+	if n == nil {
+		return true
+	}
+	if _, ok := n.(*ast.TODO); ok {
+		return true
+	}
+	v := reflect.ValueOf(n)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// startOf returns the smallest location in the tree rooted at node.
+func startOf(node ast.Node) int {
+	start := -1
+	astutils.Search(node, func(n ast.Node) bool {
+		if isEmpty(n) {
+			return false
+		}
+		if pos := n.Pos(); pos > 0 && (start < 0 || pos < start) {
+			start = pos
+		}
+		return false
+	})
+	return start
+}
+
+// paginate checks that the ORDER BY clause of a :paginate query is a unique
+// key of its output, and builds the first-page and next-page queries. The
+// next-page query applies edits, together with the ones inserting the cursor
+// predicate, to rawSQL.
+func paginate(engine config.Engine, qc *QueryCatalog, rvs []*ast.RangeVar, cols []*Column, raw *ast.RawStmt, rawSQL string, edits []source.Edit, expanded string, numParams int) (string, *Paginate, error) {
+	stmt, ok := raw.Stmt.(*ast.SelectStmt)
+	if !ok {
+		return "", nil, errors.New(":paginate queries must be SELECT statements")
+	}
+	for _, clause := range []struct {
+		name string
+		used bool
+	}{
+		{"UNION, INTERSECT or EXCEPT", stmt.Op != ast.None || stmt.Larg != nil},
+		{"GROUP BY", stmt.GroupClause != nil && len(stmt.GroupClause.Items) > 0},
+		{"HAVING", !isEmpty(stmt.HavingClause)},
+		{"LIMIT", !isEmpty(stmt.LimitCount)},
+		{"OFFSET", !isEmpty(stmt.LimitOffset)},
+		{"FOR UPDATE", stmt.LockingClause != nil && len(stmt.LockingClause.Items) > 0},
+	} {
+		if clause.used {
+			return "", nil, fmt.Errorf(":paginate queries cannot use %s", clause.name)
+		}
+	}
+	if stmt.SortClause == nil || len(stmt.SortClause.Items) == 0 {
+		return "", nil, errors.New(":paginate queries require an ORDER BY clause")
+	}
+
+	page := &Paginate{}
+	var exprs []string
+	orderPos := -1
+	keyColumns := map[string]map[string]struct{}{}
+	for _, item := range stmt.SortClause.Items {
+		sortBy, ok := item.(*ast.SortBy)
+		if !ok {
+			return "", nil, fmt.Errorf(":paginate: unsupported ORDER BY item %T", item)
+		}
+		ref, ok := sortBy.Node.(*ast.ColumnRef)
+		if !ok || hasStarRef(ref) || ref.Location <= 0 {
+			return "", nil, errors.New(":paginate: ORDER BY items must be columns")
+		}
+		loc := ref.Location - raw.StmtLocation
+		if orderPos < 0 {
+			if orderPos, ok = keywordsBefore(rawSQL, loc, "", "ORDER", "BY"); !ok {
+				return "", nil, errors.New(":paginate: cannot locate the ORDER BY clause")
+			}
+		}
+		fields := stringSlice(ref.Fields)
+		qualifier, name := "", fields[len(fields)-1]
+		if len(fields) > 1 {
+			qualifier = fields[len(fields)-2]
+		}
+		index, err := pageKeyColumn(rvs, cols, qualifier, name)
+		if err != nil {
+			return "", nil, err
+		}
+		if !isTableColumn(qc, cols[index]) {
+			return "", nil, fmt.Errorf(":paginate: ORDER BY column %q must be a table column", name)
+		}
+		if !cols[index].NotNull {
+			return "", nil, fmt.Errorf(":paginate: ORDER BY column %q must be NOT NULL", name)
+		}
+		for _, key := range page.Keys {
+			if key.Column == index {
+				return "", nil, fmt.Errorf(":paginate: ORDER BY column %q is listed more than once", name)
+			}
+		}
+		table := cols[index].Table.Name
+		if keyColumns[table] == nil {
+			keyColumns[table] = map[string]struct{}{}
+		}
+		keyColumns[table][name] = struct{}{}
+		page.Keys = append(page.Keys, PageKey{Column: index, Desc: sortBy.SortbyDir == ast.SortByDirDesc})
+		exprs = append(exprs, identAt(rawSQL, loc))
+	}
+
+	// Rows are only uniquely ordered when the sort keys contain a primary
+	// or unique key of every table in the FROM clause.
+	for _, rv := range rvs {
+		if rv.Relname == nil {
+			continue
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			return "", nil, err
+		}
+		table, err := qc.catalog.GetTable(fqn)
+		if err != nil {
+			return "", nil, fmt.Errorf(":paginate: cannot verify the keys of %q: %w", fqn.Name, err)
+		}
+		keys := table.UniqueKeys
+		if len(table.PrimaryKey) > 0 {
+			keys = append([][]string{table.PrimaryKey}, keys...)
+		}
+		if !containsKey(keyColumns[fqn.Name], keys) {
+			return "", nil, fmt.Errorf(":paginate: ORDER BY must include the primary key or a unique key of table %q", fqn.Name)
+		}
+	}
+
+	placeholder := func(n int) string {
+		if engine == config.EngineMySQL {
+			return "?"
+		}
+		return fmt.Sprintf("$%d", n)
+	}
+	var terms []string
+	for i, key := range page.Keys {
+		var conds []string
+		for j := 0; j < i; j++ {
+			conds = append(conds, fmt.Sprintf("%s = %s", exprs[j], placeholder(numParams+j+1)))
+			page.Binds = append(page.Binds, j)
+		}
+		op := ">"
+		if key.Desc {
+			op = "<"
+		}
+		conds = append(conds, fmt.Sprintf("%s %s %s", exprs[i], op, placeholder(numParams+i+1)))
+		page.Binds = append(page.Binds, i)
+		terms = append(terms, "("+strings.Join(conds, " AND ")+")")
+	}
+	if engine != config.EngineMySQL {
+		// Numbered placeholders bind every key once
+		page.Binds = page.Binds[:0]
+		for i := range page.Keys {
+			page.Binds = append(page.Binds, i)
+		}
+	}
+	predicate := "(" + strings.Join(terms, " OR ") + ")"
+
+	// The predicate goes right before ORDER BY. An existing WHERE condition
+	// is wrapped in parentheses so that it binds tighter than the AND.
+	condEnd := strings.TrimRight(rawSQL[:orderPos], " \t\r\n")
+	order := rawSQL[orderPos : orderPos+len("ORDER")]
+	pageEdits := append([]source.Edit(nil), edits...)
+	if !isEmpty(stmt.WhereClause) {
+		start := startOf(stmt.WhereClause) - raw.StmtLocation
+		where, ok := keywordsBefore(rawSQL, start, "(", "WHERE")
+		if start < 0 || !ok {
+			return "", nil, errors.New(":paginate: cannot locate the WHERE clause")
+		}
+		condStart := where + len("WHERE")
+		for condStart < len(rawSQL) && strings.IndexByte(" \t\r\n", rawSQL[condStart]) >= 0 {
+			condStart++
+		}
+		pageEdits = append(pageEdits,
+			source.Edit{
+				Location: where,
+				Old:      rawSQL[where:condStart],
+				New:      "WHERE (",
+			},
+			source.Edit{
+				Location: len(condEnd),
+				Old:      rawSQL[len(condEnd) : orderPos+len(order)],
+				New:      ")\n  AND " + predicate + "\n" + order,
+			})
+	} else {
+		pageEdits = append(pageEdits, source.Edit{
+			Location: len(condEnd),
+			Old:      rawSQL[len(condEnd) : orderPos+len(order)],
+			New:      "\nWHERE " + predicate + "\n" + order,
+		})
+	}
+	next, err := source.Mutate(rawSQL, pageEdits)
+	if err != nil {
+		return "", nil, err
+	}
+	next = strings.TrimRight(next, " \t\r\n;")
+	page.SQL = next + "\nLIMIT " + placeholder(numParams+len(page.Keys)+1)
+	first := strings.TrimRight(expanded, " \t\r\n;") + "\nLIMIT " + placeholder(numParams+1)
+	return first, page, nil
+}
+
+// pageKeyColumn finds the output column an ORDER BY item refers to. The
+// qualifier may be a table name or an alias.
+func pageKeyColumn(rvs []*ast.RangeVar, cols []*Column, qualifier, name string) (int, error) {
+	var matches []int
+	for i, c := range cols {
+		if c.Name == name && c.Table != nil {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) > 1 && qualifier != "" {
+		table := qualifier
+		for _, rv := range rvs {
+			if rv.Alias != nil && rv.Alias.Aliasname != nil && *rv.Alias.Aliasname == qualifier && rv.Relname != nil {
+				table = *rv.Relname
+			}
+		}
+		var qualified []int
+		for _, i := range matches {
+			if cols[i].Scope == qualifier || cols[i].Scope == "" && cols[i].Table.Name == table {
+				qualified = append(qualified, i)
+			}
+		}
+		matches = qualified
+	}
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf(":paginate: ORDER BY column %q is not an output column", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, fmt.Errorf(":paginate: ORDER BY column %q is ambiguous", name)
+	}
+}
+
+func isTableColumn(qc *QueryCatalog, col *Column) bool {
+	table, err := qc.GetTable(col.Table)
+	if err != nil {
+		return false
+	}
+	for _, c := range table.Columns {
+		if c.Name == col.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKey(columns map[string]struct{}, keys [][]string) bool {
+	for _, key := range keys {
+		found := len(key) > 0
+		for _, name := range key {
+			if _, ok := columns[name]; !ok {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/xiazemin/sqlc/internal/config"
 	"github.com/xiazemin/sqlc/internal/debug"
 	"github.com/xiazemin/sqlc/internal/metadata"
 	"github.com/xiazemin/sqlc/internal/opts"
@@ -31,7 +32,7 @@ func rewriteNumberedParameters(refs []paramRef, raw *ast.RawStmt, sql string) ([
 	return edits, nil
 }
 
-func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query, error) {
+func (c *Compiler) parseQuery(stmt ast.Node, src string, notNull map[int]int, o opts.Parser) (*Query, error) {
 	if o.Debug.DumpAST {
 		debug.Dump(stmt)
 	}
@@ -48,11 +49,18 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	switch n := raw.Stmt.(type) {
 	case *ast.SelectStmt:
 	case *ast.DeleteStmt:
+		if err := validate.DeleteStmt(n); err != nil {
+			return nil, err
+		}
 	case *ast.InsertStmt:
 		util.Xiazeminlog("ast.InsertStmt", n, false)
 		if err := validate.InsertStmt(n); err != nil {
 			return nil, err
 		}
+		if err := validate.GeneratedColumns(c.catalog, n); err != nil {
+			return nil, err
+		}
+	case *ast.RefreshMatViewStmt:
 	case *ast.TruncateStmt:
 	case *ast.UpdateStmt:
 	default:
@@ -81,32 +89,53 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	}
 
 	//嵌套函数
-	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw)
+	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, notNull)
 
 	//获取参数名
 	rvs := rangeVars(raw.Stmt)
+	derived := derivedTables(c.catalog, raw.Stmt)
 	util.Xiazeminlog("params", rvs, false)
 	//获取参数的 占位符号 位置 ？
 	refs := findParameters(raw.Stmt)
 	util.Xiazeminlog("params refs", refs, false)
 
+	spans, optionalEdits, err := findOptionals(c.conf.Engine, refs, rawSQL, raw.StmtLocation)
+	if err != nil {
+		return nil, err
+	}
+	edits = append(edits, optionalEdits...)
+
+	var defaults []Optional
+	if c.combo.Go.EmitInsertDefaults && c.conf.Engine == config.EngineMySQL && !o.UsePositionalParameters {
+		defaults, edits = findInsertDefaults(c.catalog, raw.Stmt, refs, edits, raw.StmtLocation)
+	}
+
+	var paramOrder []int
 	if o.UsePositionalParameters {
+		// Positional parameters are only used by the Kotlin generator, and
+		// rewriting them discards the sqlc.optional edits
+		if len(spans) > 0 {
+			return nil, errors.New("sqlc.optional is not supported by the Kotlin generator")
+		}
 		edits, err = rewriteNumberedParameters(refs, raw, rawSQL)
 		if err != nil {
 			return nil, err
 		}
 	} else {
+		if c.conf.Engine == config.EngineMySQL && (len(spans) > 0 || len(defaults) > 0 || len(uniqueParamRefs(refs)) != len(refs)) {
+			paramOrder = placeholderOrder(refs)
+		}
 		refs = uniqueParamRefs(refs)
 		sort.Slice(refs, func(i, j int) bool { return refs[i].ref.Number < refs[j].ref.Number })
 	}
 	//解析参数,这里是真正解析参数的地方 @xiazemin
-	params, err := resolveCatalogRefs(c.catalog, rvs, refs, namedParams)
+	params, err := resolveCatalogRefs(c.catalog, rvs, derived, refs, namedParams)
 
 	util.Xiazeminlog("resolveCatalogRefs", params, false)
 	if err != nil {
 		return nil, err
 	}
-	valuesParams, length, err := resolveCatalogValuesRefs(c.catalog, rvs, refs, namedParams)
+	valuesParams, length, err := resolveCatalogValuesRefs(c.catalog, rvs, derived, refs, namedParams)
 
 	util.Xiazeminlog("resolveCatalogRefs", valuesParams, false)
 	if err != nil {
@@ -124,6 +153,19 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
+	if cmd != metadata.CmdNested {
+		for _, c := range cols {
+			if c.EmbedNullable {
+				return nil, fmt.Errorf("sqlc.embed: table %q is on the nullable side of an outer join; only :nested queries can embed it", c.Scope)
+			}
+		}
+	}
+
+	orderBy, orderByEdits, err := resolveOrderBy(qc, rvs, cols, rawSQL, raw.StmtLocation)
+	if err != nil {
+		return nil, err
+	}
+	edits = append(edits, orderByEdits...)
 
 	expandEdits, err := c.expand(qc, raw)
 	if err != nil {
@@ -136,6 +178,37 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, err
 	}
 
+	var page *Paginate
+	if cmd == metadata.CmdPaginate {
+		if orderBy != nil || len(spans) > 0 {
+			return nil, errors.New(":paginate queries cannot use sqlc.order_by or sqlc.optional")
+		}
+		for _, p := range params {
+			if p.Column != nil && p.Column.IsSlice {
+				return nil, errors.New(":paginate queries cannot use slice parameters")
+			}
+		}
+		expanded, page, err = paginate(c.conf.Engine, qc, rvs, cols, raw, rawSQL, edits, expanded, len(params))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.parser.Parse(strings.NewReader(page.SQL)); err != nil {
+			return nil, fmt.Errorf("edited query syntax is invalid: %w", err)
+		}
+		page.SQL, _, err = source.StripComments(page.SQL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var nest *Nested
+	if cmd == metadata.CmdNested {
+		nest, err = nested(qc, cols)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If the query string was edited, make sure the syntax is valid
 	if expanded != rawSQL {
 		if _, err := c.parser.Parse(strings.NewReader(expanded)); err != nil {
@@ -148,11 +221,32 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, err
 	}
 
+	optionals, err := resolveOptionals(c.conf.Engine, spans, params, trimmed)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range defaults {
+		if !strings.Contains(trimmed, d.Fragment) {
+			return nil, fmt.Errorf("insert default for parameter %d not found in rewritten query", d.Param)
+		}
+		for i := range params {
+			if params[i].Number == d.Param && params[i].Column != nil {
+				params[i].Column.NotNull = false
+			}
+		}
+		optionals = append(optionals, d)
+	}
+
 	return &Query{
 		Cmd:                   cmd,
 		Comments:              comments,
 		Name:                  name,
 		Params:                params,
+		ParamOrder:            paramOrder,
+		OrderBy:               orderBy,
+		Optionals:             optionals,
+		Paginate:              page,
+		Nested:                nest,
 		Columns:               cols,
 		SQL:                   trimmed,
 		InsertValuesLen:       length,
@@ -190,13 +284,35 @@ func rangeVars(root ast.Node) []*ast.RangeVar {
 	return vars
 }
 
+// placeholderOrder returns the parameter numbers in the order their
+// placeholders appear in the query text. Engines with "?" placeholders need it
+// to bind a repeated parameter several times, or to drop the arguments of
+// optional predicates.
+func placeholderOrder(refs []paramRef) []int {
+	sorted := make([]paramRef, len(refs))
+	copy(sorted, refs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ref.Location < sorted[j].ref.Location })
+	order := make([]int, len(sorted))
+	for i, r := range sorted {
+		order[i] = r.ref.Number
+	}
+	return order
+}
+
 func uniqueParamRefs(in []paramRef) []paramRef {
-	m := make(map[int]struct{}, len(in))
+	m := make(map[int]int, len(in))
 	o := make([]paramRef, 0, len(in))
 	for _, v := range in {
-		if _, ok := m[v.ref.Number]; !ok {
-			m[v.ref.Number] = struct{}{}
+		i, ok := m[v.ref.Number]
+		if !ok {
+			m[v.ref.Number] = len(o)
 			o = append(o, v)
+			continue
+		}
+		// An IS NULL test says nothing about the type of a parameter, so
+		// prefer any other reference to it
+		if _, ok := o[i].parent.(*ast.NullTest); ok {
+			o[i] = v
 		}
 	}
 	return o
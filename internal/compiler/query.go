@@ -7,6 +7,9 @@ import (
 type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
+
+	// Nullable is set for tables on the nullable side of an outer join
+	Nullable bool
 }
 
 type Column struct {
@@ -15,6 +18,7 @@ type Column struct {
 	NotNull  bool
 	IsArray  bool
 	IsSlice  bool
+	Unsigned bool
 	Comment  string
 	Length   *int
 
@@ -22,6 +26,15 @@ type Column struct {
 	Scope string
 	Table *ast.TableName
 	Type  *ast.TypeName
+
+	// EmbedTable is set on the columns produced by sqlc.embed(table). All
+	// columns of one call share the same pointer.
+	EmbedTable *ast.TableName
+
+	// EmbedNullable is set on the columns of an embedded table on the
+	// nullable side of an outer join. Only :nested queries, which check for
+	// a missing child, can embed such a table.
+	EmbedNullable bool
 }
 
 type Query struct {
@@ -30,7 +43,12 @@ type Query struct {
 	Cmd                   string // TODO: Pick a better name. One of: one, many, exec, execrows
 	Columns               []*Column
 	Params                []Parameter
+	ParamOrder            []int // parameter numbers in placeholder order, set when arguments cannot be bound by number
 	Comments              []string
+	OrderBy               *OrderBy
+	Optionals             []Optional
+	Paginate              *Paginate
+	Nested                *Nested
 	InsertValuesLen       int64
 	InsertValuesParameter []Parameter
 
@@ -38,7 +56,7 @@ type Query struct {
 	Filename string
 }
 
-//这里存的是参数，in 之所以有问题是因为没有解析出Parameter，name 是Colum的name
+// 这里存的是参数，in 之所以有问题是因为没有解析出Parameter，name 是Colum的name
 type Parameter struct {
 	Number int
 	Column *Column
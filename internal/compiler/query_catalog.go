@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/astutils"
 	"github.com/xiazemin/sqlc/internal/sql/catalog"
 )
 
@@ -44,6 +45,40 @@ func buildQueryCatalog(c *catalog.Catalog, node ast.Node) (*QueryCatalog, error)
 	return qc, nil
 }
 
+// derivedTables returns the subqueries in the FROM clauses of a statement as
+// tables named after their aliases, so that parameters compared with their
+// columns can be typed. A subquery whose columns cannot be computed is left
+// out; outputColumns reports the error.
+func derivedTables(c *catalog.Catalog, node ast.Node) []*catalog.Table {
+	list := astutils.Search(node, func(n ast.Node) bool {
+		_, ok := n.(*ast.RangeSubselect)
+		return ok
+	})
+	if len(list.Items) == 0 {
+		return nil
+	}
+	qc, err := buildQueryCatalog(c, node)
+	if err != nil {
+		return nil
+	}
+	var tables []*catalog.Table
+	for _, item := range list.Items {
+		rs := item.(*ast.RangeSubselect)
+		if rs.Alias == nil || rs.Alias.Aliasname == nil {
+			continue
+		}
+		t, err := subselectTable(qc, rs)
+		if err != nil {
+			continue
+		}
+		tables = append(tables, &catalog.Table{
+			Rel:     t.Rel,
+			Columns: catalogColumns(t.Columns),
+		})
+	}
+	return tables
+}
+
 func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 	return &Column{
 		Table:    rel,
@@ -51,6 +86,7 @@ func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 		DataType: dataType(&c.Type),
 		NotNull:  c.IsNotNull,
 		IsArray:  c.IsArray,
+		Unsigned: c.IsUnsigned,
 		Type:     &c.Type,
 		Length:   c.Length,
 	}
@@ -3,10 +3,12 @@ package compiler
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/xiazemin/sqlc/internal/sql/ast"
 	"github.com/xiazemin/sqlc/internal/sql/astutils"
 	"github.com/xiazemin/sqlc/internal/sql/catalog"
+	"github.com/xiazemin/sqlc/internal/sql/named"
 	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
 	"github.com/xiazemin/sqlc/internal/util"
 )
@@ -19,15 +21,30 @@ func dataType(n *ast.TypeName) string {
 	}
 }
 
-func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef, names map[int]string) ([]Parameter, int64, error) {
+// applyNullability overrides the inferred nullability of parameters declared
+// with sqlc.narg or sqlc.arg(name)::not null
+func applyNullability(a []Parameter, params map[int]named.Param) {
+	for i := range a {
+		p, ok := params[a[i].Number]
+		if !ok || p.Nullability == named.NullUnspecified {
+			continue
+		}
+		if a[i].Column == nil {
+			a[i].Column = &Column{Name: p.Name, DataType: "any"}
+		}
+		a[i].Column.NotNull = p.Nullability == named.NotNull
+	}
+}
+
+func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, derived []*catalog.Table, args []paramRef, params map[int]named.Param) ([]Parameter, int64, error) {
 	aliasMap := map[string]*ast.TableName{}
 	// TODO: Deprecate defaultTable
 	var defaultTable *ast.TableName
 	var tables []*ast.TableName
 
 	parameterName := func(n int, defaultName string) string {
-		if n, ok := names[n]; ok {
-			return n
+		if p, ok := params[n]; ok {
+			return p.Name
 		}
 		return defaultName
 	}
@@ -68,6 +85,7 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 			typeMap[fqn.Schema][fqn.Name][c.Name] = cc
 		}
 	}
+	tables, catalogTables = addDerivedTables(derived, tables, catalogTables, typeMap)
 
 	var a []Parameter
 	util.Xiazeminlog("range args", args, false)
@@ -95,12 +113,24 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 			})
 
 		case *ast.A_Expr:
+			if p, ok := castParameter(n.Lexpr, ref, parameterName); ok {
+				a = append(a, p)
+				continue
+			}
+
 			// TODO: While this works for a wide range of simple expressions,
 			// more complicated expressions will cause this logic to fail.
 			list := astutils.Search(n.Lexpr, func(node ast.Node) bool {
 				_, ok := node.(*ast.ColumnRef)
 				return ok
 			})
+			// The parameter may be the left operand, as in ? = id
+			if len(list.Items) == 0 && n.Lexpr == ref.ref {
+				list = astutils.Search(n.Rexpr, func(node ast.Node) bool {
+					_, ok := node.(*ast.ColumnRef)
+					return ok
+				})
+			}
 
 			if len(list.Items) == 0 {
 				// TODO: Move this to database-specific engine package
@@ -132,7 +162,7 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 					panic("too many field items: " + strconv.Itoa(len(items)))
 				}
 
-				search := tables
+				search := scopeTables(ref, tables, typeMap, key)
 				if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
@@ -145,9 +175,11 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 					}
 				}
 
+				search = currentTable(left, alias, search, typeMap, key)
+
 				var found int
 				for _, table := range search {
-					if c, ok := typeMap[table.Schema][table.Name][key]; ok && (left.TableName == "" || left.TableName == table.Name) {
+					if c, ok := typeMap[table.Schema][table.Name][key]; ok {
 						found += 1
 						if ref.name != "" {
 							key = ref.name
@@ -159,6 +191,7 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 								DataType: dataType(&c.Type),
 								NotNull:  c.IsNotNull,
 								IsArray:  c.IsArray,
+								Unsigned: c.IsUnsigned,
 								Table:    table,
 							},
 						})
@@ -181,6 +214,25 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 				}
 			}
 
+		case *aggregateRef:
+			a = append(a, resolveAggregate(c, n, ref, catalogTables, parameterName))
+
+		case *ast.BetweenExpr:
+			p, err := resolveBetween(n, ref, tables, aliasMap, typeMap, parameterName)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, p)
+
+		case *ast.NullTest:
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:     parameterName(ref.ref.Number, ""),
+					DataType: "any",
+				},
+			})
+
 		case *ast.FuncCall:
 			fun, notNull, err := c.ResolveFuncCall(n, catalogTables)
 			if err != nil {
@@ -281,17 +333,12 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 			}
 			key := *n.Name
 
-			// TODO: Deprecate defaultTable
-			schema := defaultTable.Schema
-			rel := defaultTable.Name
-			if ref.rv != nil {
-				fqn, err := ParseTableName(ref.rv)
-				if err != nil {
-					return nil, 0, err
-				}
-				schema = fqn.Schema
-				rel = fqn.Name
+			fqn, err := targetTable(n, ref, defaultTable, tables, aliasMap, typeMap)
+			if err != nil {
+				return nil, 0, err
 			}
+			schema := fqn.Schema
+			rel := fqn.Name
 			if c, ok := typeMap[schema][rel][key]; ok {
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
@@ -300,6 +347,7 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 						DataType: dataType(&c.Type),
 						NotNull:  c.IsNotNull,
 						IsArray:  c.IsArray,
+						Unsigned: c.IsUnsigned,
 						Table:    &ast.TableName{Schema: schema, Name: rel},
 					},
 				})
@@ -323,6 +371,14 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 				Column: col,
 			})
 
+		case *castRef:
+			col := toColumn(n.cast.TypeName)
+			col.Name = parameterName(ref.ref.Number, n.name)
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: col,
+			})
+
 		case *ast.ParamRef:
 			a = append(a, Parameter{Number: ref.ref.Number})
 		case *ast.In:
@@ -357,7 +413,7 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 			var found int
 			util.Xiazeminlog("range sel-", n, false)
 			if n.Sel == nil {
-				search := tables
+				search := scopeTables(ref, tables, typeMap, key)
 				if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
@@ -385,6 +441,7 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 								DataType: dataType(&c.Type),
 								NotNull:  c.IsNotNull,
 								IsArray:  c.IsArray,
+								Unsigned: c.IsUnsigned,
 								Table:    table,
 							},
 						})
@@ -413,18 +470,19 @@ func resolveCatalogValuesRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []pa
 			fmt.Printf("unsupported reference type: %T", n)
 		}
 	}
+	applyNullability(a, params)
 	return a, 0, nil
 }
 
-func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef, names map[int]string) ([]Parameter, error) {
+func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, derived []*catalog.Table, args []paramRef, params map[int]named.Param) ([]Parameter, error) {
 	aliasMap := map[string]*ast.TableName{}
 	// TODO: Deprecate defaultTable
 	var defaultTable *ast.TableName
 	var tables []*ast.TableName
 
 	parameterName := func(n int, defaultName string) string {
-		if n, ok := names[n]; ok {
-			return n
+		if p, ok := params[n]; ok {
+			return p.Name
 		}
 		return defaultName
 	}
@@ -465,6 +523,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			typeMap[fqn.Schema][fqn.Name][c.Name] = cc
 		}
 	}
+	tables, catalogTables = addDerivedTables(derived, tables, catalogTables, typeMap)
 
 	var a []Parameter
 	util.Xiazeminlog("range args", args, false)
@@ -492,12 +551,24 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			})
 
 		case *ast.A_Expr:
+			if p, ok := castParameter(n.Lexpr, ref, parameterName); ok {
+				a = append(a, p)
+				continue
+			}
+
 			// TODO: While this works for a wide range of simple expressions,
 			// more complicated expressions will cause this logic to fail.
 			list := astutils.Search(n.Lexpr, func(node ast.Node) bool {
 				_, ok := node.(*ast.ColumnRef)
 				return ok
 			})
+			// The parameter may be the left operand, as in ? = id
+			if len(list.Items) == 0 && n.Lexpr == ref.ref {
+				list = astutils.Search(n.Rexpr, func(node ast.Node) bool {
+					_, ok := node.(*ast.ColumnRef)
+					return ok
+				})
+			}
 
 			if len(list.Items) == 0 {
 				// TODO: Move this to database-specific engine package
@@ -529,7 +600,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 					panic("too many field items: " + strconv.Itoa(len(items)))
 				}
 
-				search := tables
+				search := scopeTables(ref, tables, typeMap, key)
 				if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
@@ -542,9 +613,11 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 					}
 				}
 
+				search = currentTable(left, alias, search, typeMap, key)
+
 				var found int
 				for _, table := range search {
-					if c, ok := typeMap[table.Schema][table.Name][key]; ok && (left.TableName == "" || left.TableName == table.Name) {
+					if c, ok := typeMap[table.Schema][table.Name][key]; ok {
 						found += 1
 						if ref.name != "" {
 							key = ref.name
@@ -556,6 +629,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 								DataType: dataType(&c.Type),
 								NotNull:  c.IsNotNull,
 								IsArray:  c.IsArray,
+								Unsigned: c.IsUnsigned,
 								Table:    table,
 							},
 						})
@@ -578,6 +652,25 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				}
 			}
 
+		case *aggregateRef:
+			a = append(a, resolveAggregate(c, n, ref, catalogTables, parameterName))
+
+		case *ast.BetweenExpr:
+			p, err := resolveBetween(n, ref, tables, aliasMap, typeMap, parameterName)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, p)
+
+		case *ast.NullTest:
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:     parameterName(ref.ref.Number, ""),
+					DataType: "any",
+				},
+			})
+
 		case *ast.FuncCall:
 			/*
 				var tablse1 []*catalog.Table
@@ -591,6 +684,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 							},
 							IsNotNull: c.NotNull,
 							IsArray:   c.IsArray,
+							IsUnsigned: c.Unsigned,
 							Comment:   c.Comment,
 							Length:    c.Length,
 						})
@@ -701,17 +795,12 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			}
 			key := *n.Name
 
-			// TODO: Deprecate defaultTable
-			schema := defaultTable.Schema
-			rel := defaultTable.Name
-			if ref.rv != nil {
-				fqn, err := ParseTableName(ref.rv)
-				if err != nil {
-					return nil, err
-				}
-				schema = fqn.Schema
-				rel = fqn.Name
+			fqn, err := targetTable(n, ref, defaultTable, tables, aliasMap, typeMap)
+			if err != nil {
+				return nil, err
 			}
+			schema := fqn.Schema
+			rel := fqn.Name
 			if c, ok := typeMap[schema][rel][key]; ok {
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
@@ -720,6 +809,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 						DataType: dataType(&c.Type),
 						NotNull:  c.IsNotNull,
 						IsArray:  c.IsArray,
+						Unsigned: c.IsUnsigned,
 						Table:    &ast.TableName{Schema: schema, Name: rel},
 					},
 				})
@@ -742,6 +832,14 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				Column: col,
 			})
 
+		case *castRef:
+			col := toColumn(n.cast.TypeName)
+			col.Name = parameterName(ref.ref.Number, n.name)
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: col,
+			})
+
 		case *ast.ParamRef:
 			a = append(a, Parameter{Number: ref.ref.Number})
 		case *ast.In:
@@ -776,7 +874,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			var found int
 			util.Xiazeminlog("range sel-", n, false)
 			if n.Sel == nil {
-				search := tables
+				search := scopeTables(ref, tables, typeMap, key)
 				if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
@@ -804,6 +902,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 								DataType: dataType(&c.Type),
 								NotNull:  c.IsNotNull,
 								IsArray:  c.IsArray,
+								Unsigned: c.IsUnsigned,
 								Table:    table,
 							},
 						})
@@ -832,5 +931,234 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			fmt.Printf("unsupported reference type: %T", n)
 		}
 	}
+	applyNullability(a, params)
 	return a, nil
 }
+
+// currentTable narrows an unqualified MySQL column reference to the table the
+// parser last saw, if that table has the column. A qualified reference already
+// names its table.
+func currentTable(left *ast.ColumnRef, alias string, search []*ast.TableName, typeMap map[string]map[string]map[string]*catalog.Column, key string) []*ast.TableName {
+	if alias != "" || left.TableName == "" {
+		return search
+	}
+	for _, t := range search {
+		if t.Name != left.TableName {
+			continue
+		}
+		if _, ok := typeMap[t.Schema][t.Name][key]; ok {
+			return []*ast.TableName{t}
+		}
+	}
+	return search
+}
+
+// targetTable returns the table a ResTarget parameter is typed from. A
+// qualified SET target in a multi-table UPDATE names a table or alias. An
+// unqualified one belongs to the updated table or, failing that, to the only
+// other table with that column.
+func targetTable(n *ast.ResTarget, ref paramRef, defaultTable *ast.TableName, tables []*ast.TableName, aliasMap map[string]*ast.TableName, typeMap map[string]map[string]map[string]*catalog.Column) (*ast.TableName, error) {
+	if n.TableName != "" {
+		if fqn, ok := aliasMap[n.TableName]; ok {
+			return fqn, nil
+		}
+		for _, fqn := range tables {
+			if fqn.Name == n.TableName {
+				return fqn, nil
+			}
+		}
+		return nil, sqlerr.RelationNotFound(n.TableName)
+	}
+
+	// TODO: Deprecate defaultTable
+	fqn := defaultTable
+	if ref.rv != nil {
+		var err error
+		fqn, err = ParseTableName(ref.rv)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := typeMap[fqn.Schema][fqn.Name][*n.Name]; ok {
+		return fqn, nil
+	}
+	var found []*ast.TableName
+	for _, t := range tables {
+		if _, ok := typeMap[t.Schema][t.Name][*n.Name]; ok {
+			found = append(found, t)
+		}
+	}
+	if len(found) == 1 {
+		return found[0], nil
+	}
+	return fqn, nil
+}
+
+// castParameter types a parameter compared against a cast, such as
+// CAST(id AS CHAR) = ?, as the target type of the cast. The parameter is named
+// after the cast column.
+func castParameter(left ast.Node, ref paramRef, parameterName func(int, string) string) (Parameter, bool) {
+	tc, ok := left.(*ast.TypeCast)
+	if !ok || tc.TypeName == nil {
+		return Parameter{}, false
+	}
+	col := toColumn(tc.TypeName)
+	col.Name = ref.name
+	if col.Name == "" {
+		if cref, ok := tc.Arg.(*ast.ColumnRef); ok {
+			if fields := stringSlice(cref.Fields); len(fields) > 0 {
+				col.Name = fields[len(fields)-1]
+			}
+		}
+	}
+	col.Name = parameterName(ref.ref.Number, col.Name)
+	return Parameter{Number: ref.ref.Number, Column: col}, true
+}
+
+// resolveBetween types both bounds of a BETWEEN expression from the column it
+// is compared against. Unnamed bounds are called from_<column> and
+// to_<column>.
+func resolveBetween(n *ast.BetweenExpr, ref paramRef, tables []*ast.TableName, aliasMap map[string]*ast.TableName, typeMap map[string]map[string]map[string]*catalog.Column, parameterName func(int, string) string) (Parameter, error) {
+	left, ok := n.Expr.(*ast.ColumnRef)
+	if !ok {
+		return Parameter{
+			Number: ref.ref.Number,
+			Column: &Column{
+				Name:     parameterName(ref.ref.Number, ref.name),
+				DataType: "any",
+			},
+		}, nil
+	}
+	items := stringSlice(left.Fields)
+	var key, alias string
+	switch len(items) {
+	case 1:
+		key = items[0]
+	case 2:
+		alias = items[0]
+		key = items[1]
+	default:
+		panic("too many field items: " + strconv.Itoa(len(items)))
+	}
+
+	search := scopeTables(ref, tables, typeMap, key)
+	if alias != "" {
+		if original, ok := aliasMap[alias]; ok {
+			search = []*ast.TableName{original}
+		} else {
+			for _, fqn := range tables {
+				if fqn.Name == alias {
+					search = []*ast.TableName{fqn}
+				}
+			}
+		}
+	}
+
+	name := ref.name
+	if name == "" {
+		if bound, ok := n.Left.(*ast.ParamRef); ok && bound.Number == ref.ref.Number {
+			name = "from_" + key
+		} else {
+			name = "to_" + key
+		}
+	}
+	var params []Parameter
+	for _, table := range search {
+		if c, ok := typeMap[table.Schema][table.Name][key]; ok {
+			params = append(params, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:     parameterName(ref.ref.Number, name),
+					DataType: dataType(&c.Type),
+					NotNull:  c.IsNotNull,
+					IsArray:  c.IsArray,
+					Unsigned: c.IsUnsigned,
+					Length:   c.Length,
+					Table:    table,
+				},
+			})
+		}
+	}
+	switch len(params) {
+	case 0:
+		return Parameter{}, &sqlerr.Error{
+			Code:     "42703",
+			Message:  fmt.Sprintf("column \"%s\" does not exist", key),
+			Location: left.Location,
+		}
+	case 1:
+		return params[0], nil
+	default:
+		return Parameter{}, &sqlerr.Error{
+			Code:     "42703",
+			Message:  fmt.Sprintf("column reference \"%s\" is ambiguous", key),
+			Location: left.Location,
+		}
+	}
+}
+
+// resolveAggregate types a parameter compared against an aggregate function
+// from the return type of the function.
+func resolveAggregate(c *catalog.Catalog, n *aggregateRef, ref paramRef, tables []*catalog.Table, parameterName func(int, string) string) Parameter {
+	dataType := "any"
+	if fun, _, err := c.ResolveFuncCall(n.call, tables); err == nil && fun.ReturnType != nil {
+		dataType = fun.ReturnType.Name
+	}
+	return Parameter{
+		Number: ref.ref.Number,
+		Column: &Column{
+			Name:     parameterName(ref.ref.Number, strings.ToLower(n.call.Func.Name)),
+			DataType: dataType,
+			NotNull:  true,
+		},
+	}
+}
+
+// scopeTables narrows the tables searched for a column compared against a
+// parameter to the FROM clause of the SELECT holding the parameter, when one
+// of those tables has the column. Each branch of a set operation is then
+// resolved against its own tables.
+func scopeTables(ref paramRef, tables []*ast.TableName, typeMap map[string]map[string]map[string]*catalog.Column, key string) []*ast.TableName {
+	var scoped []*ast.TableName
+	for _, rv := range ref.scope {
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			continue
+		}
+		if _, ok := typeMap[fqn.Schema][fqn.Name][key]; !ok {
+			continue
+		}
+		for _, table := range tables {
+			if *table == *fqn {
+				scoped = append(scoped, table)
+				break
+			}
+		}
+	}
+	if len(scoped) == 0 {
+		return tables
+	}
+	return scoped
+}
+
+// addDerivedTables adds the derived tables of a query to the tables searched
+// for the columns parameters are compared with. A derived table named like a
+// table of the catalog is left out.
+func addDerivedTables(derived []*catalog.Table, tables []*ast.TableName, catalogTables []*catalog.Table, typeMap map[string]map[string]map[string]*catalog.Column) ([]*ast.TableName, []*catalog.Table) {
+	for _, table := range derived {
+		fqn := table.Rel
+		if _, exists := typeMap[fqn.Schema][fqn.Name]; exists {
+			continue
+		}
+		tables = append(tables, fqn)
+		catalogTables = append(catalogTables, table)
+		if _, exists := typeMap[fqn.Schema]; !exists {
+			typeMap[fqn.Schema] = map[string]map[string]*catalog.Column{}
+		}
+		typeMap[fqn.Schema][fqn.Name] = map[string]*catalog.Column{}
+		for _, c := range table.Columns {
+			typeMap[fqn.Schema][fqn.Name][c.Name] = c
+		}
+	}
+	return tables, catalogTables
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func isArray(n *ast.TypeName) bool {
-	if n == nil {
+	if n == nil || n.ArrayBounds == nil {
 		return false
 	}
 	return len(n.ArrayBounds.Items) > 0
@@ -27,5 +27,6 @@ func toColumn(n *ast.TypeName) *Column {
 		DataType: strings.TrimPrefix(astutils.Join(n.Names, "."), "."),
 		NotNull:  true, // XXX: How do we know if this should be null?
 		IsArray:  isArray(n),
+		Unsigned: n.Unsigned,
 	}
 }
@@ -117,6 +117,7 @@ type SQLGo struct {
 	EmitPreparedQueries bool              `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
 	EmitExactTableNames bool              `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
 	EmitEmptySlices     bool              `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
+	EmitInsertDefaults  bool              `json:"emit_insert_defaults,omitempty" yaml:"emit_insert_defaults"`
 	Package             string            `json:"package" yaml:"package"`
 	Out                 string            `json:"out" yaml:"out"`
 	Overrides           []Override        `json:"overrides,omitempty" yaml:"overrides"`
@@ -220,6 +221,7 @@ var ErrNoPackages = errors.New("no packages")
 var ErrNoPackageName = errors.New("missing package name")
 var ErrNoPackagePath = errors.New("missing package path")
 var ErrKotlinNoOutPath = errors.New("no output path")
+var ErrInsertDefaultsEngine = errors.New("emit_insert_defaults is only supported by the mysql engine")
 
 func ParseConfig(rd io.Reader) (Config, error) {
 	var buf bytes.Buffer
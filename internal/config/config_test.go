@@ -23,6 +23,17 @@ const unknownFields = `{
   "foo": "bar"
 }`
 
+const insertDefaultsEngine = `{
+  "version": "1",
+  "packages": [
+    {
+      "path": "db",
+      "engine": "postgresql",
+      "emit_insert_defaults": true
+    }
+  ]
+}`
+
 func TestBadConfigs(t *testing.T) {
 	for _, test := range []struct {
 		name string
@@ -50,6 +61,11 @@ func TestBadConfigs(t *testing.T) {
   line 3: field foo not found in type config.V1GenerateSettings`,
 			unknownFields,
 		},
+		{
+			"insert defaults engine",
+			"emit_insert_defaults is only supported by the mysql engine",
+			insertDefaultsEngine,
+		},
 	} {
 		tt := test
 		t.Run(tt.name, func(t *testing.T) {
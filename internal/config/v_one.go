@@ -27,6 +27,7 @@ type v1PackageSettings struct {
 	EmitPreparedQueries bool       `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
 	EmitExactTableNames bool       `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
 	EmitEmptySlices     bool       `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
+	EmitInsertDefaults  bool       `json:"emit_insert_defaults,omitempty" yaml:"emit_insert_defaults"`
 	Overrides           []Override `json:"overrides" yaml:"overrides"`
 	DSN                 string     `json:"dsn" yaml:"dsn"`
 	Tables              []string   `json:"tables" yaml:"tables"`
@@ -72,6 +73,9 @@ func v1ParseConfig(rd io.Reader) (Config, error) {
 		if settings.Packages[j].Engine == "" {
 			settings.Packages[j].Engine = EnginePostgreSQL
 		}
+		if settings.Packages[j].EmitInsertDefaults && settings.Packages[j].Engine != EngineMySQL {
+			return config, ErrInsertDefaultsEngine
+		}
 	}
 	return settings.Translate(), nil
 }
@@ -111,6 +115,7 @@ func (c *V1GenerateSettings) Translate() Config {
 					EmitPreparedQueries: pkg.EmitPreparedQueries,
 					EmitExactTableNames: pkg.EmitExactTableNames,
 					EmitEmptySlices:     pkg.EmitEmptySlices,
+					EmitInsertDefaults:  pkg.EmitInsertDefaults,
 					Package:             pkg.Name,
 					Out:                 pkg.Path,
 					Overrides:           pkg.Overrides,
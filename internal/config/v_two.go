@@ -45,6 +45,9 @@ func v2ParseConfig(rd io.Reader) (Config, error) {
 			if conf.SQL[j].Gen.Go.Package == "" {
 				conf.SQL[j].Gen.Go.Package = filepath.Base(conf.SQL[j].Gen.Go.Out)
 			}
+			if conf.SQL[j].Gen.Go.EmitInsertDefaults && conf.SQL[j].Engine != EngineMySQL {
+				return conf, ErrInsertDefaultsEngine
+			}
 			for i := range conf.SQL[j].Gen.Go.Overrides {
 				if err := conf.SQL[j].Gen.Go.Overrides[i].Parse(); err != nil {
 					return conf, err
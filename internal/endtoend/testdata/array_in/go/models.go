@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type Bar struct {
+	// Default: auto increment
 	ID int32
 }
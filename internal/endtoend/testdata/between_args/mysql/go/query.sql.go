@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"time"
+)
+
+const listByPrice = `-- name: ListByPrice :many
+SELECT id, name, price, created_at FROM products WHERE price BETWEEN ? AND ?
+`
+
+type ListByPriceParams struct {
+	FromPrice int32
+
+	ToPrice int32
+}
+
+func (q *Queries) ListByPrice(ctx context.Context, arg ListByPriceParams) ([]Product, error) {
+
+	listByPrice := listByPrice
+
+	rows, err := q.db.QueryContext(ctx, listByPrice, arg.FromPrice, arg.ToPrice)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Product
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Price,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutsideDates = `-- name: ListOutsideDates :many
+SELECT id, name, price, created_at FROM products WHERE created_at NOT BETWEEN ? AND ?
+`
+
+type ListOutsideDatesParams struct {
+	FromCreatedAt time.Time
+
+	ToCreatedAt time.Time
+}
+
+func (q *Queries) ListOutsideDates(ctx context.Context, arg ListOutsideDatesParams) ([]Product, error) {
+
+	listOutsideDates := listOutsideDates
+
+	rows, err := q.db.QueryContext(ctx, listOutsideDates, arg.FromCreatedAt, arg.ToCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Product
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Price,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,12 @@
+CREATE TABLE products (
+  id         bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name       text NOT NULL,
+  price      int NOT NULL,
+  created_at datetime NOT NULL
+);
+
+-- name: ListByPrice :many
+SELECT * FROM products WHERE price BETWEEN ? AND ?;
+
+-- name: ListOutsideDates :many
+SELECT * FROM products WHERE created_at NOT BETWEEN ? AND ?;
@@ -0,0 +1,58 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// NullUint64 represents a uint64 that may be null.
+type NullUint64 struct {
+	Uint64 uint64
+	Valid  bool // Valid is true if Uint64 is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullUint64) Scan(src interface{}) error {
+	var v uint64
+	var err error
+	switch s := src.(type) {
+	case nil:
+		n.Uint64, n.Valid = 0, false
+		return nil
+	case int64:
+		v = uint64(s)
+	case uint64:
+		v = s
+	case []byte:
+		v, err = strconv.ParseUint(string(s), 10, 64)
+	case string:
+		v, err = strconv.ParseUint(s, 10, 64)
+	default:
+		return fmt.Errorf("unsupported scan type for NullUint64: %T", src)
+	}
+	if err != nil {
+		return err
+	}
+	n.Uint64, n.Valid = uint64(v), true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullUint64) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return uint64(n.Uint64), nil
+}
+
+type User struct {
+	// Default: auto increment
+	ID        int64
+	Age       sql.NullInt32
+	CreatedAt time.Time
+}
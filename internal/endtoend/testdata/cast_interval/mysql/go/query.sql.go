@@ -0,0 +1,82 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"time"
+)
+
+const listUsersBefore = `-- name: ListUsersBefore :many
+SELECT id FROM users
+WHERE created_at + INTERVAL ? HOUR < ?
+`
+
+type ListUsersBeforeParams struct {
+	IntervalHour int64
+
+	CreatedAt time.Time
+}
+
+func (q *Queries) ListUsersBefore(ctx context.Context, arg ListUsersBeforeParams) ([]int64, error) {
+
+	listUsersBefore := listUsersBefore
+
+	rows, err := q.db.QueryContext(ctx, listUsersBefore, arg.IntervalHour, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersSince = `-- name: ListUsersSince :many
+SELECT id, CAST(age AS UNSIGNED) AS age, CONVERT(id, CHAR) AS label
+FROM users
+WHERE created_at > DATE_SUB(NOW(), INTERVAL ? DAY)
+`
+
+type ListUsersSinceRow struct {
+	ID    int64
+	Age   NullUint64
+	Label string
+}
+
+func (q *Queries) ListUsersSince(ctx context.Context, intervalDay int64) ([]ListUsersSinceRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listUsersSince, intervalDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersSinceRow
+	for rows.Next() {
+		var i ListUsersSinceRow
+		if err := rows.Scan(&i.ID, &i.Age, &i.Label); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
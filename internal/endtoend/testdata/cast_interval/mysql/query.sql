@@ -0,0 +1,14 @@
+CREATE TABLE users (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    age int,
+    created_at datetime NOT NULL
+);
+
+-- name: ListUsersSince :many
+SELECT id, CAST(age AS UNSIGNED) AS age, CONVERT(id, CHAR) AS label
+FROM users
+WHERE created_at > DATE_SUB(NOW(), INTERVAL ? DAY);
+
+-- name: ListUsersBefore :many
+SELECT id FROM users
+WHERE created_at + INTERVAL ? HOUR < ?;
@@ -0,0 +1,9 @@
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    age int,
+    created_at timestamp NOT NULL
+);
+
+-- name: ListUsers :many
+SELECT id, CAST(age AS bigint) AS age, id::text AS label
+FROM users;
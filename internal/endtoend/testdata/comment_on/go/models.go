@@ -3,6 +3,7 @@
 package querytest
 
 import (
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -27,6 +28,23 @@ func (e *FooMood) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e FooMood) Value() (driver.Value, error) {
+	if e == FooMoodNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of FooMood.
+func (e FooMood) Valid() bool {
+	switch e {
+	case FooMoodSad, FooMoodOk, FooMoodHappy:
+		return true
+	}
+	return false
+}
+
 // this is the bar table
 type FooBar struct {
 	// this is the baz column
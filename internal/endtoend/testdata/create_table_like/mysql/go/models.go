@@ -7,6 +7,7 @@ import (
 )
 
 type SuperUser struct {
+	// Default: auto increment
 	ID          int32
 	FirstName   string
 	LastName    sql.NullString
@@ -14,6 +15,7 @@ type SuperUser struct {
 }
 
 type User struct {
+	// Default: auto increment
 	ID       int32
 	LastName sql.NullString
 	Age      int32
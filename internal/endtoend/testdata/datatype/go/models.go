@@ -53,8 +53,11 @@ type DtNumeric struct {
 	E sql.NullString
 	F sql.NullFloat64
 	G sql.NullFloat64
+	// Default: auto increment
 	H int16
+	// Default: auto increment
 	I sql.NullInt32
+	// Default: auto increment
 	J sql.NullInt64
 	K int16
 	L sql.NullInt32
@@ -69,8 +72,11 @@ type DtNumericNotNull struct {
 	E string
 	F float32
 	G float64
+	// Default: auto increment
 	H int16
+	// Default: auto increment
 	I int32
+	// Default: auto increment
 	J int64
 	K int16
 	L int32
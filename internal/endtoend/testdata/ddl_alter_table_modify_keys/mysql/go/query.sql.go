@@ -0,0 +1,151 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const listUsers = `-- name: ListUsers :paginate
+SELECT id, email FROM users
+ORDER BY id
+LIMIT ?
+`
+
+const listUsersNext = `-- name: ListUsers :paginate
+SELECT id, email FROM users
+WHERE ((id > ?))
+ORDER BY id
+LIMIT ?
+`
+
+type listUsersCursor struct {
+	ID int64 `json:"id"`
+}
+
+// ListUsers returns at most pageSize rows following cursor, and the
+// cursor of the next page. The first page is returned for an empty cursor, and
+// the next cursor is empty after the last page.
+func (q *Queries) ListUsers(ctx context.Context, cursor string, pageSize int32) ([]User, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size: %d", pageSize)
+	}
+	query := listUsers
+	args := []interface{}{}
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		var key listUsersCursor
+		if err := json.Unmarshal(b, &key); err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		query = listUsersNext
+		args = append(args, key.ID)
+	}
+	args = append(args, pageSize)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Email); err != nil {
+			return nil, "", err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		b, err := json.Marshal(listUsersCursor{ID: last.ID})
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return items, next, nil
+}
+
+const listUsersByEmail = `-- name: ListUsersByEmail :paginate
+SELECT id, email FROM users
+ORDER BY email
+LIMIT ?
+`
+
+const listUsersByEmailNext = `-- name: ListUsersByEmail :paginate
+SELECT id, email FROM users
+WHERE ((email > ?))
+ORDER BY email
+LIMIT ?
+`
+
+type listUsersByEmailCursor struct {
+	Email string `json:"email"`
+}
+
+// ListUsersByEmail returns at most pageSize rows following cursor, and the
+// cursor of the next page. The first page is returned for an empty cursor, and
+// the next cursor is empty after the last page.
+func (q *Queries) ListUsersByEmail(ctx context.Context, cursor string, pageSize int32) ([]User, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size: %d", pageSize)
+	}
+	query := listUsersByEmail
+	args := []interface{}{}
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		var key listUsersByEmailCursor
+		if err := json.Unmarshal(b, &key); err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		query = listUsersByEmailNext
+		args = append(args, key.Email)
+	}
+	args = append(args, pageSize)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Email); err != nil {
+			return nil, "", err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		b, err := json.Marshal(listUsersByEmailCursor{Email: last.Email})
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return items, next, nil
+}
@@ -0,0 +1,16 @@
+CREATE TABLE users (
+  id    int NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  email varchar(255) NOT NULL,
+  UNIQUE KEY (email)
+);
+
+ALTER TABLE users MODIFY COLUMN id bigint NOT NULL AUTO_INCREMENT;
+ALTER TABLE users MODIFY COLUMN email varchar(320) NOT NULL;
+
+-- name: ListUsers :paginate
+SELECT * FROM users
+ORDER BY id;
+
+-- name: ListUsersByEmail :paginate
+SELECT id, email FROM users
+ORDER BY email;
@@ -3,6 +3,7 @@
 package querytest
 
 import (
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -24,3 +25,20 @@ func (e *Status) Scan(src interface{}) error {
 	}
 	return nil
 }
+
+// Value implements the driver.Valuer interface.
+func (e Status) Value() (driver.Value, error) {
+	if e == StatusNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of Status.
+func (e Status) Valid() bool {
+	switch e {
+	case StatusOpen, StatusShut:
+		return true
+	}
+	return false
+}
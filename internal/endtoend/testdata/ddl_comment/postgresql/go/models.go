@@ -4,6 +4,7 @@ package querytest
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -26,6 +27,23 @@ func (e *FooBat) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e FooBat) Value() (driver.Value, error) {
+	if e == FooBatNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of FooBat.
+func (e FooBat) Valid() bool {
+	switch e {
+	case FooBatBat:
+		return true
+	}
+	return false
+}
+
 // Table comment
 type FooBar struct {
 	// Column comment
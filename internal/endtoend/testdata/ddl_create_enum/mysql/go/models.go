@@ -3,6 +3,7 @@
 package querytest
 
 import (
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -30,6 +31,20 @@ func (e *FooFoobar) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e FooFoobar) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of FooFoobar.
+func (e FooFoobar) Valid() bool {
+	switch e {
+	case FooFoobarFooA, FooFoobarFooB, FooFoobarFooC, FooFoobarFooD, FooFoobarFooe, FooFoobarFoof, FooFoobarFoog:
+		return true
+	}
+	return false
+}
+
 type Foo struct {
 	Foobar FooFoobar
 }
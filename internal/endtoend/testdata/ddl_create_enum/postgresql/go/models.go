@@ -3,6 +3,7 @@
 package querytest
 
 import (
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -30,6 +31,23 @@ func (e *Foobar) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e Foobar) Value() (driver.Value, error) {
+	if e == FoobarNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of Foobar.
+func (e Foobar) Valid() bool {
+	switch e {
+	case FoobarFooA, FoobarFooB, FoobarFooC, FoobarFooD, FoobarFooe, FoobarFoof, FoobarFoog:
+		return true
+	}
+	return false
+}
+
 type Foo struct {
 	Val Foobar
 }
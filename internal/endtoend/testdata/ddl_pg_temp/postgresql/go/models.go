@@ -7,5 +7,6 @@ import (
 )
 
 type PgTempMigrate struct {
+	// Default: auto increment
 	Val sql.NullInt32
 }
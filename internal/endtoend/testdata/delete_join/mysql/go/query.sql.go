@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const deleteAuthorWithBooks = `-- name: DeleteAuthorWithBooks :exec
+DELETE a, b FROM authors a
+LEFT JOIN books b ON b.author_id = a.id
+WHERE a.id = ?
+`
+
+func (q *Queries) DeleteAuthorWithBooks(ctx context.Context, id int64) error {
+
+	_, err := q.db.ExecContext(ctx, deleteAuthorWithBooks, id)
+	return err
+}
+
+const deleteAuthorsWithoutBooks = `-- name: DeleteAuthorsWithoutBooks :execrows
+DELETE FROM authors USING authors
+LEFT JOIN books ON books.author_id = authors.id
+WHERE books.id IS NULL AND authors.name LIKE ?
+`
+
+func (q *Queries) DeleteAuthorsWithoutBooks(ctx context.Context, name string) (int64, error) {
+
+	result, err := q.db.ExecContext(ctx, deleteAuthorsWithoutBooks, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteBooksByAuthorName = `-- name: DeleteBooksByAuthorName :exec
+DELETE b FROM books b
+JOIN authors a ON a.id = b.author_id
+WHERE a.name = ?
+`
+
+func (q *Queries) DeleteBooksByAuthorName(ctx context.Context, name string) error {
+
+	_, err := q.db.ExecContext(ctx, deleteBooksByAuthorName, name)
+	return err
+}
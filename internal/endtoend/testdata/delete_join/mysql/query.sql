@@ -0,0 +1,25 @@
+CREATE TABLE authors (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE books (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    author_id bigint NOT NULL,
+    title text NOT NULL
+);
+
+-- name: DeleteBooksByAuthorName :exec
+DELETE b FROM books b
+JOIN authors a ON a.id = b.author_id
+WHERE a.name = ?;
+
+-- name: DeleteAuthorWithBooks :exec
+DELETE a, b FROM authors a
+LEFT JOIN books b ON b.author_id = a.id
+WHERE a.id = ?;
+
+-- name: DeleteAuthorsWithoutBooks :execrows
+DELETE FROM authors USING authors
+LEFT JOIN books ON books.author_id = authors.id
+WHERE books.id IS NULL AND authors.name LIKE ?;
@@ -0,0 +1,15 @@
+CREATE TABLE authors (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE books (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    author_id bigint NOT NULL,
+    title text NOT NULL
+);
+
+-- name: DeleteAuthorWithBooks :exec
+DELETE a, reviews FROM authors a
+JOIN books b ON b.author_id = a.id
+WHERE a.id = ?;
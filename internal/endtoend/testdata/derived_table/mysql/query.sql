@@ -0,0 +1,22 @@
+CREATE TABLE products (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL,
+    category text NOT NULL,
+    price int NOT NULL,
+    description text
+);
+
+-- name: ListCheapProducts :many
+SELECT p.product_name, p.description
+FROM (SELECT name AS product_name, description FROM products WHERE price < ?) p
+WHERE p.product_name LIKE ?;
+
+-- name: ListCategoryTotals :many
+SELECT totals.category, totals.total
+FROM (
+    SELECT category, COUNT(*) AS total FROM products GROUP BY category
+) AS totals
+WHERE totals.total > ?;
+
+-- name: ListProductsInCategory :many
+SELECT * FROM (SELECT id, name FROM products WHERE category = ?) sub;
@@ -0,0 +1,122 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const listCategoryTotals = `-- name: ListCategoryTotals :many
+SELECT totals.category, totals.total
+FROM (
+    SELECT category, COUNT(*) AS total FROM products GROUP BY category
+) AS totals
+WHERE totals.total > $1
+`
+
+type ListCategoryTotalsRow struct {
+	Category string
+	Total    int64
+}
+
+func (q *Queries) ListCategoryTotals(ctx context.Context, total int64) ([]ListCategoryTotalsRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listCategoryTotals, total)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryTotalsRow
+	for rows.Next() {
+		var i ListCategoryTotalsRow
+		if err := rows.Scan(&i.Category, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCheapProducts = `-- name: ListCheapProducts :many
+SELECT p.product_name, p.description
+FROM (SELECT name AS product_name, description FROM products WHERE price < $1) p
+WHERE p.product_name LIKE $2
+`
+
+type ListCheapProductsParams struct {
+	Price int32
+
+	ProductName string
+}
+
+type ListCheapProductsRow struct {
+	ProductName string
+	Description sql.NullString
+}
+
+func (q *Queries) ListCheapProducts(ctx context.Context, arg ListCheapProductsParams) ([]ListCheapProductsRow, error) {
+
+	listCheapProducts := listCheapProducts
+
+	rows, err := q.db.QueryContext(ctx, listCheapProducts, arg.Price, arg.ProductName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCheapProductsRow
+	for rows.Next() {
+		var i ListCheapProductsRow
+		if err := rows.Scan(&i.ProductName, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsInCategory = `-- name: ListProductsInCategory :many
+SELECT id, name FROM (SELECT id, name FROM products WHERE category = $1) sub
+`
+
+type ListProductsInCategoryRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListProductsInCategory(ctx context.Context, category string) ([]ListProductsInCategoryRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listProductsInCategory, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsInCategoryRow
+	for rows.Next() {
+		var i ListProductsInCategoryRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,22 @@
+CREATE TABLE products (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL,
+    category text NOT NULL,
+    price int NOT NULL,
+    description text
+);
+
+-- name: ListCheapProducts :many
+SELECT p.product_name, p.description
+FROM (SELECT name AS product_name, description FROM products WHERE price < $1) p
+WHERE p.product_name LIKE $2;
+
+-- name: ListCategoryTotals :many
+SELECT totals.category, totals.total
+FROM (
+    SELECT category, COUNT(*) AS total FROM products GROUP BY category
+) AS totals
+WHERE totals.total > $1;
+
+-- name: ListProductsInCategory :many
+SELECT * FROM (SELECT id, name FROM products WHERE category = $1) sub;
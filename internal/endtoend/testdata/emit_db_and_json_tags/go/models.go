@@ -7,6 +7,7 @@ import (
 )
 
 type User struct {
+	// Default: auto increment
 	ID        int32          `db:"id" json:"id"`
 	FirstName string         `db:"first_name" json:"first_name"`
 	LastName  sql.NullString `db:"last_name" json:"last_name"`
@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type OrdersPriority string
+
+const (
+	OrdersPriorityLow  OrdersPriority = "low"
+	OrdersPriorityHigh OrdersPriority = "high"
+	OrdersPriorityNULL OrdersPriority = "null"
+)
+
+func (e *OrdersPriority) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrdersPriority(s)
+	case string:
+		*e = OrdersPriority(s)
+
+	case nil:
+		*e = OrdersPriorityNULL
+
+	default:
+		return fmt.Errorf("unsupported scan type for OrdersPriority: %T", src)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e OrdersPriority) Value() (driver.Value, error) {
+	if e == OrdersPriorityNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of OrdersPriority.
+func (e OrdersPriority) Valid() bool {
+	switch e {
+	case OrdersPriorityLow, OrdersPriorityHigh:
+		return true
+	}
+	return false
+}
+
+type OrdersStatus string
+
+const (
+	OrdersStatusPending   OrdersStatus = "pending"
+	OrdersStatusShipped   OrdersStatus = "shipped"
+	OrdersStatusDelivered OrdersStatus = "delivered"
+)
+
+func (e *OrdersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrdersStatus(s)
+	case string:
+		*e = OrdersStatus(s)
+
+	default:
+		return fmt.Errorf("unsupported scan type for OrdersStatus: %T", src)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e OrdersStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of OrdersStatus.
+func (e OrdersStatus) Valid() bool {
+	switch e {
+	case OrdersStatusPending, OrdersStatusShipped, OrdersStatusDelivered:
+		return true
+	}
+	return false
+}
+
+type Order struct {
+	// Default: auto increment
+	ID       int64
+	Status   OrdersStatus
+	Priority OrdersPriority
+}
@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listOrdersByStatus = `-- name: ListOrdersByStatus :many
+SELECT id, status, priority FROM orders WHERE status = ?
+`
+
+func (q *Queries) ListOrdersByStatus(ctx context.Context, status OrdersStatus) ([]Order, error) {
+
+	rows, err := q.db.QueryContext(ctx, listOrdersByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(&i.ID, &i.Status, &i.Priority); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setPriority = `-- name: SetPriority :exec
+UPDATE orders SET priority = ? WHERE id = ?
+`
+
+type SetPriorityParams struct {
+	Priority OrdersPriority
+
+	ID int64
+}
+
+func (q *Queries) SetPriority(ctx context.Context, arg SetPriorityParams) error {
+
+	setPriority := setPriority
+
+	_, err := q.db.ExecContext(ctx, setPriority, arg.Priority, arg.ID)
+	return err
+}
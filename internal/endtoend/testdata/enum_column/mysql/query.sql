@@ -0,0 +1,11 @@
+CREATE TABLE orders (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    status ENUM('pending', 'shipped', 'delivered') NOT NULL,
+    priority ENUM('low', 'high')
+);
+
+-- name: ListOrdersByStatus :many
+SELECT id, status, priority FROM orders WHERE status = ?;
+
+-- name: SetPriority :exec
+UPDATE orders SET priority = ? WHERE id = ?;
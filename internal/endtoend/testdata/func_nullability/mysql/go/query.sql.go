@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const listCustomers = `-- name: ListCustomers :many
+SELECT
+    upper(customer) AS customer,
+    length(customer) AS customer_length,
+    upper(note) AS note
+FROM orders
+`
+
+type ListCustomersRow struct {
+	Customer       string
+	CustomerLength int32
+	Note           sql.NullString
+}
+
+func (q *Queries) ListCustomers(ctx context.Context) ([]ListCustomersRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listCustomers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCustomersRow
+	for rows.Next() {
+		var i ListCustomersRow
+		if err := rows.Scan(&i.Customer, &i.CustomerLength, &i.Note); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const orderTotals = `-- name: OrderTotals :one
+SELECT
+    COUNT(*) AS orders,
+    COUNT(discount) AS discounted,
+    SUM(quantity) AS quantity,
+    MAX(quantity) AS largest,
+    IFNULL(SUM(quantity), 0) AS quantity_or_zero,
+    COALESCE(SUM(discount), 0) AS discount_or_zero,
+    IFNULL(MIN(id), 0) AS first_id,
+    COALESCE(MIN(id), 0) AS first_id_coalesce
+FROM orders
+`
+
+type OrderTotalsRow struct {
+	Orders          int64
+	Discounted      int64
+	Quantity        sql.NullInt64
+	Largest         sql.NullInt64
+	QuantityOrZero  int64
+	DiscountOrZero  int64
+	FirstID         int64
+	FirstIDCoalesce int64
+}
+
+func (q *Queries) OrderTotals(ctx context.Context) (OrderTotalsRow, error) {
+
+	row := q.db.QueryRowContext(ctx, orderTotals)
+	var i OrderTotalsRow
+	err := row.Scan(
+		&i.Orders,
+		&i.Discounted,
+		&i.Quantity,
+		&i.Largest,
+		&i.QuantityOrZero,
+		&i.DiscountOrZero,
+		&i.FirstID,
+		&i.FirstIDCoalesce,
+	)
+	return i, err
+}
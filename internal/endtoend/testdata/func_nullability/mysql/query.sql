@@ -0,0 +1,26 @@
+CREATE TABLE orders (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    customer text NOT NULL,
+    quantity bigint NOT NULL,
+    discount bigint,
+    note text
+);
+
+-- name: OrderTotals :one
+SELECT
+    COUNT(*) AS orders,
+    COUNT(discount) AS discounted,
+    SUM(quantity) AS quantity,
+    MAX(quantity) AS largest,
+    IFNULL(SUM(quantity), 0) AS quantity_or_zero,
+    COALESCE(SUM(discount), 0) AS discount_or_zero,
+    IFNULL(MIN(id), 0) AS first_id,
+    COALESCE(MIN(id), 0) AS first_id_coalesce
+FROM orders;
+
+-- name: ListCustomers :many
+SELECT
+    upper(customer) AS customer,
+    length(customer) AS customer_length,
+    upper(note) AS note
+FROM orders;
@@ -0,0 +1,26 @@
+CREATE TABLE orders (
+    id BIGSERIAL PRIMARY KEY,
+    customer text NOT NULL,
+    quantity integer NOT NULL,
+    discount bigint,
+    note text
+);
+
+-- name: OrderTotals :one
+SELECT
+    COUNT(*) AS orders,
+    COUNT(discount) AS discounted,
+    SUM(quantity) AS quantity,
+    MAX(quantity) AS largest,
+    MIN(customer) AS first_customer,
+    AVG(quantity) AS average,
+    COALESCE(SUM(quantity), 0) AS quantity_or_zero,
+    COALESCE(MAX(discount), 0) AS discount_or_zero
+FROM orders;
+
+-- name: ListCustomers :many
+SELECT
+    upper(customer) AS customer,
+    length(customer) AS customer_length,
+    upper(note) AS note
+FROM orders;
@@ -8,8 +8,11 @@ import (
 )
 
 type Td3Code struct {
-	ID        int32
+	// Default: auto increment
+	ID int32
+	// Default: now()
 	TsCreated time.Time
+	// Default: now()
 	TsUpdated time.Time
 	CreatedBy string
 	UpdatedBy string
@@ -19,8 +22,11 @@ type Td3Code struct {
 }
 
 type Td3TestCode struct {
-	ID        int32
+	// Default: auto increment
+	ID int32
+	// Default: now()
 	TsCreated time.Time
+	// Default: now()
 	TsUpdated time.Time
 	CreatedBy string
 	UpdatedBy string
@@ -0,0 +1,13 @@
+CREATE TABLE events (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL,
+    priority int NOT NULL DEFAULT 1,
+    created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    slug varchar(255) GENERATED ALWAYS AS (lower(name)) VIRTUAL
+);
+
+-- name: CreateEvent :exec
+INSERT INTO events (name, priority, created_at) VALUES (?, ?, ?);
+
+-- name: CreateEventWithID :exec
+INSERT INTO events (id, name) VALUES (?, ?);
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Event struct {
+	// Default: auto increment
+	ID   int64
+	Name string
+	// Default: 1
+	Priority int32
+	// Default: CURRENT_TIMESTAMP()
+	CreatedAt time.Time
+	// Default: 'none'
+	Note sql.NullString
+	// Generated: LOWER(`name`)
+	Slug sql.NullString
+}
@@ -0,0 +1,83 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+const createEvent = `-- name: CreateEvent :exec
+INSERT INTO events (name, priority, created_at) VALUES (?, /* sqlc.default:1 */ ?, /* sqlc.default:2 */ ?)
+`
+
+type CreateEventParams struct {
+	Name string
+
+	Priority sql.NullInt32
+
+	CreatedAt sql.NullTime
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) error {
+	query := createEvent
+	var args []interface{}
+	args = append(args, arg.Name)
+	if arg.Priority.Valid {
+		args = append(args, arg.Priority)
+	} else {
+		query = strings.Replace(query, "/* sqlc.default:1 */ ?", "DEFAULT", 1)
+	}
+	if arg.CreatedAt.Valid {
+		args = append(args, arg.CreatedAt)
+	} else {
+		query = strings.Replace(query, "/* sqlc.default:2 */ ?", "DEFAULT", 1)
+	}
+
+	_, err := q.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+const createEventWithID = `-- name: CreateEventWithID :exec
+INSERT INTO events (id, name) VALUES (/* sqlc.default:1 */ ?, ?)
+`
+
+type CreateEventWithIDParams struct {
+	ID sql.NullInt64
+
+	Name string
+}
+
+func (q *Queries) CreateEventWithID(ctx context.Context, arg CreateEventWithIDParams) error {
+	query := createEventWithID
+	var args []interface{}
+	if arg.ID.Valid {
+		args = append(args, arg.ID)
+	} else {
+		query = strings.Replace(query, "/* sqlc.default:1 */ ?", "DEFAULT", 1)
+	}
+	args = append(args, arg.Name)
+
+	_, err := q.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+const createEventWithNote = `-- name: CreateEventWithNote :exec
+INSERT INTO events (name, note) VALUES (?, ?)
+`
+
+type CreateEventWithNoteParams struct {
+	Name string
+
+	Note sql.NullString
+}
+
+func (q *Queries) CreateEventWithNote(ctx context.Context, arg CreateEventWithNoteParams) error {
+
+	createEventWithNote := createEventWithNote
+
+	_, err := q.db.ExecContext(ctx, createEventWithNote, arg.Name, arg.Note)
+	return err
+}
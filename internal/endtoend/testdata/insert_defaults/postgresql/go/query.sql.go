@@ -0,0 +1,18 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createEvent = `-- name: CreateEvent :exec
+INSERT INTO events (name, priority) VALUES ($1, DEFAULT)
+`
+
+func (q *Queries) CreateEvent(ctx context.Context, name string) error {
+
+	_, err := q.db.ExecContext(ctx, createEvent, name)
+	return err
+}
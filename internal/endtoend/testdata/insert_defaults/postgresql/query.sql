@@ -0,0 +1,10 @@
+CREATE TABLE events (
+    id BIGSERIAL PRIMARY KEY,
+    code int GENERATED BY DEFAULT AS IDENTITY,
+    name text NOT NULL,
+    priority int NOT NULL DEFAULT 1,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+
+-- name: CreateEvent :exec
+INSERT INTO events (name, priority) VALUES ($1, DEFAULT);
@@ -0,0 +1,7 @@
+CREATE TABLE events (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL
+);
+
+-- name: CreateEvent :exec
+INSERT INTO events (name) VALUES ($1);
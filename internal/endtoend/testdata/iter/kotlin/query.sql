@@ -0,0 +1,7 @@
+CREATE TABLE foo (id BIGSERIAL PRIMARY KEY, name text NOT NULL, bio text);
+
+-- name: IterFoo :iter
+SELECT id, name, bio FROM foo WHERE name = $1;
+
+-- name: IterNames :iter
+SELECT name FROM foo;
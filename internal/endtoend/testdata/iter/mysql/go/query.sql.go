@@ -0,0 +1,66 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const iterFoo = `-- name: IterFoo :iter
+SELECT id, name, bio FROM foo WHERE name = ?
+`
+
+func (q *Queries) IterFoo(ctx context.Context, name string, fn func(Foo) error) error {
+
+	rows, err := q.db.QueryContext(ctx, iterFoo, name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var i Foo
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+const iterNames = `-- name: IterNames :iter
+SELECT name FROM foo
+`
+
+func (q *Queries) IterNames(ctx context.Context, fn func(string) error) error {
+
+	rows, err := q.db.QueryContext(ctx, iterNames)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		if err := fn(name); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
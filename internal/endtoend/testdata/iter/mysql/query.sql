@@ -0,0 +1,7 @@
+CREATE TABLE foo (id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY, name text NOT NULL, bio text);
+
+-- name: IterFoo :iter
+SELECT id, name, bio FROM foo WHERE name = ?;
+
+-- name: IterNames :iter
+SELECT name FROM foo;
@@ -7,10 +7,12 @@ import (
 )
 
 type Bar struct {
-	ID    int64
+	// Default: auto increment
+	ID    uint64
 	Title sql.NullString
 }
 
 type Foo struct {
-	ID int64
+	// Default: auto increment
+	ID uint64
 }
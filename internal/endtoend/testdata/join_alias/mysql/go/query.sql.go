@@ -16,12 +16,13 @@ WHERE f.id = ?
 `
 
 type AliasExpandRow struct {
-	ID    int64
-	ID_2  int64
+	ID    uint64
+	ID_2  uint64
 	Title sql.NullString
 }
 
-func (q *Queries) AliasExpand(ctx context.Context, id int64) ([]AliasExpandRow, error) {
+func (q *Queries) AliasExpand(ctx context.Context, id uint64) ([]AliasExpandRow, error) {
+
 	rows, err := q.db.QueryContext(ctx, aliasExpand, id)
 	if err != nil {
 		return nil, err
@@ -52,11 +53,12 @@ WHERE f.id = ?
 `
 
 type AliasJoinRow struct {
-	ID    int64
+	ID    uint64
 	Title sql.NullString
 }
 
-func (q *Queries) AliasJoin(ctx context.Context, id int64) ([]AliasJoinRow, error) {
+func (q *Queries) AliasJoin(ctx context.Context, id uint64) ([]AliasJoinRow, error) {
+
 	rows, err := q.db.QueryContext(ctx, aliasJoin, id)
 	if err != nil {
 		return nil, err
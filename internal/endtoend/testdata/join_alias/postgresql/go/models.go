@@ -7,10 +7,12 @@ import (
 )
 
 type Bar struct {
+	// Default: auto increment
 	ID    int32
 	Title sql.NullString
 }
 
 type Foo struct {
+	// Default: auto increment
 	ID int32
 }
@@ -5,10 +5,13 @@ package querytest
 import ()
 
 type Bar struct {
-	ID int64
+	// Default: auto increment
+	ID uint64
 }
 
 type Foo struct {
-	ID  int64
-	Bar int64
+	// Default: auto increment
+	ID uint64
+	// Default: auto increment
+	Bar uint64
 }
@@ -15,13 +15,17 @@ WHERE bar.id = ? AND foo.id = ?
 `
 
 type TableNameParams struct {
-	ID   int64
-	ID_2 int64
+	ID uint64
+
+	ID_2 uint64
 }
 
-func (q *Queries) TableName(ctx context.Context, arg TableNameParams) (int64, error) {
+func (q *Queries) TableName(ctx context.Context, arg TableNameParams) (uint64, error) {
+
+	tableName := tableName
+
 	row := q.db.QueryRowContext(ctx, tableName, arg.ID, arg.ID_2)
-	var id int64
+	var id uint64
 	err := row.Scan(&id)
 	return id, err
 }
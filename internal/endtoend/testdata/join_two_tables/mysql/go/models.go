@@ -5,14 +5,18 @@ package querytest
 import ()
 
 type Bar struct {
-	ID int64
+	// Default: auto increment
+	ID uint64
 }
 
 type Baz struct {
-	ID int64
+	// Default: auto increment
+	ID uint64
 }
 
 type Foo struct {
-	BarID int64
-	BazID int64
+	// Default: auto increment
+	BarID uint64
+	// Default: auto increment
+	BazID uint64
 }
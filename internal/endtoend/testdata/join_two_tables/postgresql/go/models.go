@@ -5,14 +5,18 @@ package querytest
 import ()
 
 type Bar struct {
+	// Default: auto increment
 	ID int32
 }
 
 type Baz struct {
+	// Default: auto increment
 	ID int32
 }
 
 type Foo struct {
+	// Default: auto increment
 	BarID int32
+	// Default: auto increment
 	BazID int32
 }
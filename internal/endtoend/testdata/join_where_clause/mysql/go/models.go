@@ -5,10 +5,12 @@ package querytest
 import ()
 
 type Bar struct {
-	ID    int64
+	// Default: auto increment
+	ID    uint64
 	Owner string
 }
 
 type Foo struct {
-	Barid int64
+	// Default: auto increment
+	Barid uint64
 }
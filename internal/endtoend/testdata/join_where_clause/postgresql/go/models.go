@@ -5,10 +5,12 @@ package querytest
 import ()
 
 type Bar struct {
+	// Default: auto increment
 	ID    int32
 	Owner string
 }
 
 type Foo struct {
+	// Default: auto increment
 	Barid int32
 }
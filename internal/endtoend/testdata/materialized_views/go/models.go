@@ -7,8 +7,13 @@ import (
 )
 
 type Author struct {
+	// Default: auto increment
 	ID     int64
 	Name   string
 	Bio    sql.NullString
 	Gender sql.NullInt32
 }
+
+type AuthorsName struct {
+	Name string
+}
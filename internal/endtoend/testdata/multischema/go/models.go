@@ -7,10 +7,13 @@ import (
 )
 
 type Bar struct {
+	// Default: auto increment
 	ID int32
 }
 
 type Foo struct {
-	ID  int32
+	// Default: auto increment
+	ID int32
+	// Default: auto increment
 	Bar sql.NullInt32
 }
@@ -0,0 +1,111 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listAuthorsWithMode = `-- name: ListAuthorsWithMode :many
+SELECT id, @@sql_mode FROM authors WHERE name = ?
+`
+
+type ListAuthorsWithModeRow struct {
+	ID      int64
+	Column2 interface{}
+}
+
+func (q *Queries) ListAuthorsWithMode(ctx context.Context, name string) ([]ListAuthorsWithModeRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listAuthorsWithMode, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsWithModeRow
+	for rows.Next() {
+		var i ListAuthorsWithModeRow
+		if err := rows.Scan(&i.ID, &i.Column2); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const numberAuthors = `-- name: NumberAuthors :many
+SELECT id, @n := @n + 1 FROM authors
+`
+
+type NumberAuthorsRow struct {
+	ID      int64
+	Column2 interface{}
+}
+
+func (q *Queries) NumberAuthors(ctx context.Context) ([]NumberAuthorsRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, numberAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NumberAuthorsRow
+	for rows.Next() {
+		var i NumberAuthorsRow
+		if err := rows.Scan(&i.ID, &i.Column2); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAuthors = `-- name: SearchAuthors :many
+SELECT id, name, bio FROM authors
+WHERE id > ? AND (name = ? OR bio = ?)
+`
+
+type SearchAuthorsParams struct {
+	MinID int64
+
+	Term string
+}
+
+func (q *Queries) SearchAuthors(ctx context.Context, arg SearchAuthorsParams) ([]Author, error) {
+
+	searchAuthors := searchAuthors
+
+	rows, err := q.db.QueryContext(ctx, searchAuthors, arg.MinID, arg.Term, arg.Term)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
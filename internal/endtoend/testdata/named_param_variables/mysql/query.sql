@@ -0,0 +1,15 @@
+CREATE TABLE authors (
+  id   bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name text NOT NULL,
+  bio  text
+);
+
+-- name: SearchAuthors :many
+SELECT * FROM authors
+WHERE id > @min_id AND (name = @term OR bio = @term);
+
+-- name: ListAuthorsWithMode :many
+SELECT id, @@sql_mode FROM authors WHERE name = ?;
+
+-- name: NumberAuthors :many
+SELECT id, @n := @n + 1 FROM authors;
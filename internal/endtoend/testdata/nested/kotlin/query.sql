@@ -0,0 +1,21 @@
+CREATE TABLE orders (
+  id       BIGSERIAL PRIMARY KEY,
+  customer text NOT NULL
+);
+
+CREATE TABLE order_items (
+  id       BIGSERIAL PRIMARY KEY,
+  order_id bigint NOT NULL,
+  sku      text NOT NULL,
+  note     text
+);
+
+-- name: ListOrders :nested
+SELECT sqlc.embed(orders), sqlc.embed(order_items)
+FROM orders LEFT JOIN order_items ON order_items.order_id = orders.id
+ORDER BY orders.id;
+
+-- name: ListCustomerOrders :nested
+SELECT sqlc.embed(o), sqlc.embed(i)
+FROM orders o JOIN order_items i ON i.order_id = o.id
+WHERE o.customer = $1;
@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listCustomerOrders = `-- name: ListCustomerOrders :nested
+SELECT o.id, o.customer, i.id, i.order_id, i.sku, i.note
+FROM orders o JOIN order_items i ON i.order_id = o.id
+WHERE o.customer = ?
+`
+
+type ListCustomerOrdersRow struct {
+	Order      Order
+	OrderItems []OrderItem
+}
+
+// ListCustomerOrders returns one result per parent row, holding the child rows
+// joined to it.
+func (q *Queries) ListCustomerOrders(ctx context.Context, customer string) ([]ListCustomerOrdersRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listCustomerOrders, customer)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCustomerOrdersRow
+	index := map[int64]int{}
+	for rows.Next() {
+		var i ListCustomerOrdersRow
+		var child OrderItem
+		var nulls struct {
+			ID      *int64
+			OrderID *int64
+			Sku     *string
+		}
+		if err := rows.Scan(
+			&i.Order.ID,
+			&i.Order.Customer,
+			&nulls.ID,
+			&nulls.OrderID,
+			&nulls.Sku,
+			&child.Note,
+		); err != nil {
+			return nil, err
+		}
+		key := i.Order.ID
+		n, ok := index[key]
+		if !ok {
+			i.OrderItems = []OrderItem{}
+			n = len(items)
+			index[key] = n
+			items = append(items, i)
+		}
+		if nulls.ID != nil {
+			child.ID = *nulls.ID
+			child.OrderID = *nulls.OrderID
+			child.Sku = *nulls.Sku
+			items[n].OrderItems = append(items[n].OrderItems, child)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrders = `-- name: ListOrders :nested
+SELECT orders.id, orders.customer, order_items.id, order_items.order_id, order_items.sku, order_items.note
+FROM orders LEFT JOIN order_items ON order_items.order_id = orders.id
+ORDER BY orders.id
+`
+
+type ListOrdersRow struct {
+	Order      Order
+	OrderItems []OrderItem
+}
+
+// ListOrders returns one result per parent row, holding the child rows
+// joined to it.
+func (q *Queries) ListOrders(ctx context.Context) ([]ListOrdersRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listOrders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrdersRow
+	index := map[int64]int{}
+	for rows.Next() {
+		var i ListOrdersRow
+		var child OrderItem
+		var nulls struct {
+			ID      *int64
+			OrderID *int64
+			Sku     *string
+		}
+		if err := rows.Scan(
+			&i.Order.ID,
+			&i.Order.Customer,
+			&nulls.ID,
+			&nulls.OrderID,
+			&nulls.Sku,
+			&child.Note,
+		); err != nil {
+			return nil, err
+		}
+		key := i.Order.ID
+		n, ok := index[key]
+		if !ok {
+			i.OrderItems = []OrderItem{}
+			n = len(items)
+			index[key] = n
+			items = append(items, i)
+		}
+		if nulls.ID != nil {
+			child.ID = *nulls.ID
+			child.OrderID = *nulls.OrderID
+			child.Sku = *nulls.Sku
+			items[n].OrderItems = append(items[n].OrderItems, child)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,71 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name, created_at FROM authors
+WHERE name <> ?
+ORDER BY /* sqlc.order_by:sort */ created_at ASC
+LIMIT ?
+`
+
+type ListAuthorsParams struct {
+	Name string
+
+	Limit int32
+}
+
+// ListAuthorsSort is the ordering applied to `sort`.
+type ListAuthorsSort string
+
+const (
+	ListAuthorsSortCreatedAtAsc  ListAuthorsSort = "created_at ASC"
+	ListAuthorsSortCreatedAtDesc ListAuthorsSort = "created_at DESC"
+	ListAuthorsSortNameAsc       ListAuthorsSort = "name ASC"
+	ListAuthorsSortNameDesc      ListAuthorsSort = "name DESC"
+)
+
+// Valid reports whether e is one of the allowed orderings.
+func (e ListAuthorsSort) Valid() bool {
+	switch e {
+	case ListAuthorsSortCreatedAtAsc, ListAuthorsSortCreatedAtDesc, ListAuthorsSortNameAsc, ListAuthorsSortNameDesc:
+		return true
+	}
+	return false
+}
+
+func (q *Queries) ListAuthors(ctx context.Context, arg ListAuthorsParams, sort ListAuthorsSort) ([]Author, error) {
+	if !sort.Valid() {
+		return nil, fmt.Errorf("invalid sort: %q", sort)
+	}
+
+	listAuthors := listAuthors
+
+	rows, err := q.db.QueryContext(ctx, strings.Replace(listAuthors, "/* sqlc.order_by:sort */ created_at ASC", string(sort), 1), arg.Name, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,11 @@
+CREATE TABLE authors (
+  id         bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name       text NOT NULL,
+  created_at timestamp NOT NULL
+);
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+WHERE name <> ?
+ORDER BY sqlc.order_by('sort', allowed: created_at, name)
+LIMIT ?;
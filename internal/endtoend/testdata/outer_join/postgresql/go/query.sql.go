@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const listAllAuthorsAndBooks = `-- name: ListAllAuthorsAndBooks :many
+SELECT authors.name, books.title
+FROM authors
+FULL JOIN books ON books.author_id = authors.id
+`
+
+type ListAllAuthorsAndBooksRow struct {
+	Name  sql.NullString
+	Title sql.NullString
+}
+
+func (q *Queries) ListAllAuthorsAndBooks(ctx context.Context) ([]ListAllAuthorsAndBooksRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listAllAuthorsAndBooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAllAuthorsAndBooksRow
+	for rows.Next() {
+		var i ListAllAuthorsAndBooksRow
+		if err := rows.Scan(&i.Name, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsWithBookCounts = `-- name: ListAuthorsWithBookCounts :many
+SELECT authors.name, counts.total
+FROM authors
+LEFT JOIN (
+    SELECT author_id, COUNT(*) AS total FROM books GROUP BY author_id
+) AS counts ON counts.author_id = authors.id
+`
+
+type ListAuthorsWithBookCountsRow struct {
+	Name  string
+	Total sql.NullInt64
+}
+
+func (q *Queries) ListAuthorsWithBookCounts(ctx context.Context) ([]ListAuthorsWithBookCountsRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listAuthorsWithBookCounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsWithBookCountsRow
+	for rows.Next() {
+		var i ListAuthorsWithBookCountsRow
+		if err := rows.Scan(&i.Name, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsWithBooks = `-- name: ListAuthorsWithBooks :many
+SELECT authors.name, books.id AS book_id, books.title
+FROM authors
+LEFT JOIN books ON books.author_id = authors.id
+`
+
+type ListAuthorsWithBooksRow struct {
+	Name   string
+	BookID sql.NullInt64
+	Title  sql.NullString
+}
+
+func (q *Queries) ListAuthorsWithBooks(ctx context.Context) ([]ListAuthorsWithBooksRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listAuthorsWithBooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsWithBooksRow
+	for rows.Next() {
+		var i ListAuthorsWithBooksRow
+		if err := rows.Scan(&i.Name, &i.BookID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksWithAuthors = `-- name: ListBooksWithAuthors :many
+SELECT a.name, b.title
+FROM authors a
+RIGHT JOIN books b ON b.author_id = a.id
+`
+
+type ListBooksWithAuthorsRow struct {
+	Name  sql.NullString
+	Title string
+}
+
+func (q *Queries) ListBooksWithAuthors(ctx context.Context) ([]ListBooksWithAuthorsRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listBooksWithAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBooksWithAuthorsRow
+	for rows.Next() {
+		var i ListBooksWithAuthorsRow
+		if err := rows.Scan(&i.Name, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,32 @@
+CREATE TABLE authors (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE books (
+    id BIGSERIAL PRIMARY KEY,
+    author_id bigint NOT NULL,
+    title text NOT NULL
+);
+
+-- name: ListAuthorsWithBooks :many
+SELECT authors.name, books.id AS book_id, books.title
+FROM authors
+LEFT JOIN books ON books.author_id = authors.id;
+
+-- name: ListBooksWithAuthors :many
+SELECT a.name, b.title
+FROM authors a
+RIGHT JOIN books b ON b.author_id = a.id;
+
+-- name: ListAuthorsWithBookCounts :many
+SELECT authors.name, counts.total
+FROM authors
+LEFT JOIN (
+    SELECT author_id, COUNT(*) AS total FROM books GROUP BY author_id
+) AS counts ON counts.author_id = authors.id;
+
+-- name: ListAllAuthorsAndBooks :many
+SELECT authors.name, books.title
+FROM authors
+FULL JOIN books ON books.author_id = authors.id;
@@ -0,0 +1,28 @@
+CREATE TABLE orgs (
+  id   BIGSERIAL PRIMARY KEY,
+  name text NOT NULL
+);
+
+CREATE TABLE users (
+  id     BIGSERIAL PRIMARY KEY,
+  org_id bigint NOT NULL,
+  name   text NOT NULL,
+  email  varchar(255) NOT NULL,
+  UNIQUE (email)
+);
+
+-- name: ListUsers :paginate
+SELECT * FROM users
+ORDER BY id;
+
+-- name: ListUsersByEmail :paginate
+SELECT id, email FROM users
+WHERE org_id = $1 OR concat(name, ', ') = $2
+ORDER BY email DESC;
+
+-- name: ListOrgUsers :paginate
+SELECT u.id, u.name, o.id, o.name
+FROM users u
+JOIN orgs o ON o.id = u.org_id
+WHERE o.name <> 'ORDER BY'
+ORDER BY o.id, u.id;
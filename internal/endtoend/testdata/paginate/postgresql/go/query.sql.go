@@ -0,0 +1,258 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const listOrgUsers = `-- name: ListOrgUsers :paginate
+SELECT u.id, u.name, o.id, o.name
+FROM users u
+JOIN orgs o ON o.id = u.org_id
+WHERE o.name <> 'ORDER BY'
+ORDER BY o.id, u.id
+LIMIT $1
+`
+
+type ListOrgUsersRow struct {
+	ID     int64
+	Name   string
+	ID_2   int64
+	Name_2 string
+}
+
+const listOrgUsersNext = `-- name: ListOrgUsers :paginate
+SELECT u.id, u.name, o.id, o.name
+FROM users u
+JOIN orgs o ON o.id = u.org_id
+WHERE (o.name <> 'ORDER BY')
+  AND ((o.id > $1) OR (o.id = $1 AND u.id > $2))
+ORDER BY o.id, u.id
+LIMIT $3
+`
+
+type listOrgUsersCursor struct {
+	ID_2 int64 `json:"id_2"`
+	ID   int64 `json:"id"`
+}
+
+// ListOrgUsers returns at most pageSize rows following cursor, and the
+// cursor of the next page. The first page is returned for an empty cursor, and
+// the next cursor is empty after the last page.
+func (q *Queries) ListOrgUsers(ctx context.Context, cursor string, pageSize int32) ([]ListOrgUsersRow, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size: %d", pageSize)
+	}
+	query := listOrgUsers
+	args := []interface{}{}
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		var key listOrgUsersCursor
+		if err := json.Unmarshal(b, &key); err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		query = listOrgUsersNext
+		args = append(args, key.ID_2, key.ID)
+	}
+	args = append(args, pageSize)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var items []ListOrgUsersRow
+	for rows.Next() {
+		var i ListOrgUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ID_2,
+			&i.Name_2,
+		); err != nil {
+			return nil, "", err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		b, err := json.Marshal(listOrgUsersCursor{ID_2: last.ID_2, ID: last.ID})
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return items, next, nil
+}
+
+const listUsers = `-- name: ListUsers :paginate
+SELECT id, org_id, name, email FROM users
+ORDER BY id
+LIMIT $1
+`
+
+const listUsersNext = `-- name: ListUsers :paginate
+SELECT id, org_id, name, email FROM users
+WHERE ((id > $1))
+ORDER BY id
+LIMIT $2
+`
+
+type listUsersCursor struct {
+	ID int64 `json:"id"`
+}
+
+// ListUsers returns at most pageSize rows following cursor, and the
+// cursor of the next page. The first page is returned for an empty cursor, and
+// the next cursor is empty after the last page.
+func (q *Queries) ListUsers(ctx context.Context, cursor string, pageSize int32) ([]User, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size: %d", pageSize)
+	}
+	query := listUsers
+	args := []interface{}{}
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		var key listUsersCursor
+		if err := json.Unmarshal(b, &key); err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		query = listUsersNext
+		args = append(args, key.ID)
+	}
+	args = append(args, pageSize)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Name,
+			&i.Email,
+		); err != nil {
+			return nil, "", err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		b, err := json.Marshal(listUsersCursor{ID: last.ID})
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return items, next, nil
+}
+
+const listUsersByEmail = `-- name: ListUsersByEmail :paginate
+SELECT id, email FROM users
+WHERE org_id = $1 OR concat(name, ', ') = $2
+ORDER BY email DESC
+LIMIT $3
+`
+
+type ListUsersByEmailParams struct {
+	OrgID int64
+
+	Name string
+}
+
+type ListUsersByEmailRow struct {
+	ID    int64
+	Email string
+}
+
+const listUsersByEmailNext = `-- name: ListUsersByEmail :paginate
+SELECT id, email FROM users
+WHERE (org_id = $1 OR concat(name, ', ') = $2)
+  AND ((email < $3))
+ORDER BY email DESC
+LIMIT $4
+`
+
+type listUsersByEmailCursor struct {
+	Email string `json:"email"`
+}
+
+// ListUsersByEmail returns at most pageSize rows following cursor, and the
+// cursor of the next page. The first page is returned for an empty cursor, and
+// the next cursor is empty after the last page.
+func (q *Queries) ListUsersByEmail(ctx context.Context, arg ListUsersByEmailParams, cursor string, pageSize int32) ([]ListUsersByEmailRow, string, error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("invalid page size: %d", pageSize)
+	}
+	query := listUsersByEmail
+	args := []interface{}{arg.OrgID, arg.Name}
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		var key listUsersByEmailCursor
+		if err := json.Unmarshal(b, &key); err != nil {
+			return nil, "", fmt.Errorf("invalid cursor: %w", err)
+		}
+		query = listUsersByEmailNext
+		args = append(args, key.Email)
+	}
+	args = append(args, pageSize)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var items []ListUsersByEmailRow
+	for rows.Next() {
+		var i ListUsersByEmailRow
+		if err := rows.Scan(&i.ID, &i.Email); err != nil {
+			return nil, "", err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		b, err := json.Marshal(listUsersByEmailCursor{Email: last.Email})
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return items, next, nil
+}
@@ -7,6 +7,7 @@ import (
 )
 
 type User struct {
+	// Default: auto increment
 	ID        int32
 	FirstName sql.NullString
 	LastName  sql.NullString
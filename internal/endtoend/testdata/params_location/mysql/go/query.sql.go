@@ -83,8 +83,8 @@ WHERE orders.price > ?
 `
 
 type ListUserOrdersRow struct {
-	ID        int32
-	FirstName string
+	ID        sql.NullInt32
+	FirstName sql.NullString
 	Price     string
 }
 
@@ -7,12 +7,14 @@ import (
 )
 
 type Order struct {
+	// Default: auto increment
 	ID     int32
 	Price  string
 	UserID int32
 }
 
 type User struct {
+	// Default: auto increment
 	ID        int32
 	FirstName string
 	LastName  sql.NullString
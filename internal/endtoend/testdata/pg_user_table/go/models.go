@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type User struct {
+	// Default: auto increment
 	ID int64
 }
@@ -7,6 +7,7 @@ import (
 )
 
 type Author struct {
+	// Default: auto increment
 	ID   int64
 	Name string
 	Bio  sql.NullString
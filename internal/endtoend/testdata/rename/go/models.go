@@ -3,6 +3,7 @@
 package querytest
 
 import (
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -26,6 +27,23 @@ func (e *IPProtocol) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e IPProtocol) Value() (driver.Value, error) {
+	if e == IpProtocolNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of IPProtocol.
+func (e IPProtocol) Valid() bool {
+	switch e {
+	case IPProtocolTCP, IpProtocolIp, IpProtocolIcmp:
+		return true
+	}
+	return false
+}
+
 type BarNew struct {
 	IDNew int32
 	IpOld IPProtocol
@@ -13,10 +13,14 @@ INSERT INTO foo.bar (id, name) VALUES (?, ?)
 `
 
 type SchemaScopedCreateParams struct {
-	ID   int64
+	ID uint64
+
 	Name string
 }
 
 func (q *Queries) SchemaScopedCreate(ctx context.Context, arg SchemaScopedCreateParams) (sql.Result, error) {
+
+	schemaScopedCreate := schemaScopedCreate
+
 	return q.db.ExecContext(ctx, schemaScopedCreate, arg.ID, arg.Name)
 }
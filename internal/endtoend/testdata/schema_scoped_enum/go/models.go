@@ -3,6 +3,7 @@
 package querytest
 
 import (
+	"database/sql/driver"
 	"fmt"
 )
 
@@ -25,6 +26,23 @@ func (e *FooTypeUserRole) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+func (e FooTypeUserRole) Value() (driver.Value, error) {
+	if e == FooTypeUserRoleNULL {
+		return nil, nil
+	}
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of FooTypeUserRole.
+func (e FooTypeUserRole) Valid() bool {
+	switch e {
+	case FooTypeUserRoleAdmin, FooTypeUserRoleUser:
+		return true
+	}
+	return false
+}
+
 type FooUser struct {
 	Role FooTypeUserRole
 }
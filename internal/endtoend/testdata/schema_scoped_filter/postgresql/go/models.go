@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type FooBar struct {
+	// Default: auto increment
 	ID int32
 }
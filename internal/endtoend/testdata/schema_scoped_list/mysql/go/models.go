@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type FooBar struct {
-	ID int64
+	// Default: auto increment
+	ID uint64
 }
@@ -11,15 +11,16 @@ const schemaScopedList = `-- name: SchemaScopedList :many
 SELECT id FROM foo.bar
 `
 
-func (q *Queries) SchemaScopedList(ctx context.Context) ([]int64, error) {
+func (q *Queries) SchemaScopedList(ctx context.Context) ([]uint64, error) {
+
 	rows, err := q.db.QueryContext(ctx, schemaScopedList)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int64
+	var items []uint64
 	for rows.Next() {
-		var id int64
+		var id uint64
 		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
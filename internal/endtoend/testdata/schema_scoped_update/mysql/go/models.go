@@ -5,6 +5,7 @@ package querytest
 import ()
 
 type FooBar struct {
-	ID   int64
+	// Default: auto increment
+	ID   uint64
 	Name string
 }
@@ -13,10 +13,14 @@ UPDATE foo.bar SET name = ? WHERE id = ?
 
 type SchemaScopedUpdateParams struct {
 	Name string
-	ID   int64
+
+	ID uint64
 }
 
 func (q *Queries) SchemaScopedUpdate(ctx context.Context, arg SchemaScopedUpdateParams) error {
+
+	schemaScopedUpdate := schemaScopedUpdate
+
 	_, err := q.db.ExecContext(ctx, schemaScopedUpdate, arg.Name, arg.ID)
 	return err
 }
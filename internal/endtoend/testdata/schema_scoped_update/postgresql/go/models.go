@@ -5,6 +5,7 @@ package querytest
 import ()
 
 type FooBar struct {
+	// Default: auto increment
 	ID   int32
 	Name string
 }
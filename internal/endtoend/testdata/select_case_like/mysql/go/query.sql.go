@@ -0,0 +1,63 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT id,
+  CASE WHEN score > ? THEN 'high' ELSE 'low' END AS level,
+  bio IS NULL AS no_bio,
+  -score AS negated
+FROM users
+WHERE name LIKE ? AND name NOT REGEXP ?
+`
+
+type SearchUsersParams struct {
+	Score int32
+
+	Name string
+
+	Name_2 string
+}
+
+type SearchUsersRow struct {
+	ID      int64
+	Level   string
+	NoBio   bool
+	Negated int32
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error) {
+
+	searchUsers := searchUsers
+
+	rows, err := q.db.QueryContext(ctx, searchUsers, arg.Score, arg.Name, arg.Name_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUsersRow
+	for rows.Next() {
+		var i SearchUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Level,
+			&i.NoBio,
+			&i.Negated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,14 @@
+CREATE TABLE users (
+  id    bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name  varchar(255) NOT NULL,
+  score int NOT NULL,
+  bio   text
+);
+
+-- name: SearchUsers :many
+SELECT id,
+  CASE WHEN score > ? THEN 'high' ELSE 'low' END AS level,
+  bio IS NULL AS no_bio,
+  -score AS negated
+FROM users
+WHERE name LIKE ? AND name NOT REGEXP ?;
@@ -7,6 +7,7 @@ import (
 )
 
 type Bar struct {
+	// Default: auto increment
 	ID   int32
 	Name sql.NullString
 }
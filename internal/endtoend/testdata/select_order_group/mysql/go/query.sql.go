@@ -0,0 +1,58 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const topCustomers = `-- name: TopCustomers :many
+SELECT customer, COUNT(*) AS orders, SUM(total) AS spent
+FROM orders
+GROUP BY customer
+HAVING COUNT(*) > ?
+ORDER BY spent DESC
+LIMIT ? OFFSET ?
+`
+
+type TopCustomersParams struct {
+	Count int64
+
+	Limit int32
+
+	Offset int32
+}
+
+type TopCustomersRow struct {
+	Customer string
+	Orders   int64
+	Spent    sql.NullInt32
+}
+
+func (q *Queries) TopCustomers(ctx context.Context, arg TopCustomersParams) ([]TopCustomersRow, error) {
+
+	topCustomers := topCustomers
+
+	rows, err := q.db.QueryContext(ctx, topCustomers, arg.Count, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TopCustomersRow
+	for rows.Next() {
+		var i TopCustomersRow
+		if err := rows.Scan(&i.Customer, &i.Orders, &i.Spent); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
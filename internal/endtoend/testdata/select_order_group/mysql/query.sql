@@ -0,0 +1,13 @@
+CREATE TABLE orders (
+  id       bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  customer varchar(255) NOT NULL,
+  total    int NOT NULL
+);
+
+-- name: TopCustomers :many
+SELECT customer, COUNT(*) AS orders, SUM(total) AS spent
+FROM orders
+GROUP BY customer
+HAVING COUNT(*) > ?
+ORDER BY spent DESC
+LIMIT ? OFFSET ?;
@@ -7,6 +7,7 @@ import (
 )
 
 type User struct {
+	// Default: auto increment
 	ID        int32
 	FirstName string
 	LastName  sql.NullString
@@ -0,0 +1,82 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const allEmails = `-- name: AllEmails :many
+SELECT email FROM customers
+UNION
+SELECT email FROM suppliers
+`
+
+func (q *Queries) AllEmails(ctx context.Context) ([]sql.NullString, error) {
+
+	rows, err := q.db.QueryContext(ctx, allEmails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullString
+	for rows.Next() {
+		var email sql.NullString
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const customerEmailsAfter = `-- name: CustomerEmailsAfter :many
+SELECT id, email FROM customers WHERE id > ?
+UNION ALL
+SELECT id, email FROM suppliers WHERE id > ?
+`
+
+type CustomerEmailsAfterParams struct {
+	ID int64
+
+	ID_2 int64
+}
+
+type CustomerEmailsAfterRow struct {
+	ID    int64
+	Email sql.NullString
+}
+
+func (q *Queries) CustomerEmailsAfter(ctx context.Context, arg CustomerEmailsAfterParams) ([]CustomerEmailsAfterRow, error) {
+
+	customerEmailsAfter := customerEmailsAfter
+
+	rows, err := q.db.QueryContext(ctx, customerEmailsAfter, arg.ID, arg.ID_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CustomerEmailsAfterRow
+	for rows.Next() {
+		var i CustomerEmailsAfterRow
+		if err := rows.Scan(&i.ID, &i.Email); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,19 @@
+CREATE TABLE customers (
+  id    bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  email varchar(255) NOT NULL
+);
+
+CREATE TABLE suppliers (
+  id    bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  email varchar(255)
+);
+
+-- name: AllEmails :many
+SELECT email FROM customers
+UNION
+SELECT email FROM suppliers;
+
+-- name: CustomerEmailsAfter :many
+SELECT id, email FROM customers WHERE id > ?
+UNION ALL
+SELECT id, email FROM suppliers WHERE id > ?;
@@ -0,0 +1,105 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+type PostsFlags []string
+
+var postsFlagsMembers = map[string]bool{
+	"draft":  true,
+	"pinned": true,
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *PostsFlags) Scan(src interface{}) error {
+	var str string
+	switch v := src.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case nil:
+		*s = nil
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for PostsFlags: %T", src)
+	}
+	members := PostsFlags{}
+	if str != "" {
+		for _, m := range strings.Split(str, ",") {
+			if !postsFlagsMembers[m] {
+				return fmt.Errorf("unknown PostsFlags member: %q", m)
+			}
+			members = append(members, m)
+		}
+	}
+	*s = members
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (s PostsFlags) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	for _, m := range s {
+		if !postsFlagsMembers[m] {
+			return nil, fmt.Errorf("unknown PostsFlags member: %q", m)
+		}
+	}
+	return strings.Join(s, ","), nil
+}
+
+type PostsTags []string
+
+var postsTagsMembers = map[string]bool{
+	"go":    true,
+	"sql":   true,
+	"mysql": true,
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *PostsTags) Scan(src interface{}) error {
+	var str string
+	switch v := src.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("unsupported scan type for PostsTags: %T", src)
+	}
+	members := PostsTags{}
+	if str != "" {
+		for _, m := range strings.Split(str, ",") {
+			if !postsTagsMembers[m] {
+				return fmt.Errorf("unknown PostsTags member: %q", m)
+			}
+			members = append(members, m)
+		}
+	}
+	*s = members
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (s PostsTags) Value() (driver.Value, error) {
+	for _, m := range s {
+		if !postsTagsMembers[m] {
+			return nil, fmt.Errorf("unknown PostsTags member: %q", m)
+		}
+	}
+	return strings.Join(s, ","), nil
+}
+
+type Post struct {
+	// Default: auto increment
+	ID    int64
+	Tags  PostsTags
+	Flags PostsFlags
+}
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createPost = `-- name: CreatePost :exec
+INSERT INTO posts (tags, flags) VALUES (?, ?)
+`
+
+type CreatePostParams struct {
+	Tags PostsTags
+
+	Flags PostsFlags
+}
+
+func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) error {
+
+	createPost := createPost
+
+	_, err := q.db.ExecContext(ctx, createPost, arg.Tags, arg.Flags)
+	return err
+}
+
+const getPost = `-- name: GetPost :one
+SELECT id, tags, flags FROM posts WHERE id = ?
+`
+
+func (q *Queries) GetPost(ctx context.Context, id int64) (Post, error) {
+
+	row := q.db.QueryRowContext(ctx, getPost, id)
+	var i Post
+	err := row.Scan(&i.ID, &i.Tags, &i.Flags)
+	return i, err
+}
@@ -0,0 +1,11 @@
+CREATE TABLE posts (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tags SET('go', 'sql', 'mysql') NOT NULL,
+    flags SET('draft', 'pinned')
+);
+
+-- name: GetPost :one
+SELECT id, tags, flags FROM posts WHERE id = ?;
+
+-- name: CreatePost :exec
+INSERT INTO posts (tags, flags) VALUES (?, ?);
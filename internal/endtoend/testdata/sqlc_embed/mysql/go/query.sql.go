@@ -0,0 +1,66 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const getUser = `-- name: GetUser :one
+SELECT users.id, users.name, users.bio FROM users WHERE id = ?
+`
+
+type GetUserRow struct {
+	User User
+}
+
+func (q *Queries) GetUser(ctx context.Context, id int64) (GetUserRow, error) {
+
+	row := q.db.QueryRowContext(ctx, getUser, id)
+	var i GetUserRow
+	err := row.Scan(&i.User.ID, &i.User.Name, &i.User.Bio)
+	return i, err
+}
+
+const listPostsWithAuthor = `-- name: ListPostsWithAuthor :many
+SELECT p.id, p.title, u.id, u.name, u.bio
+FROM posts p
+JOIN users u ON u.id = p.user_id
+`
+
+type ListPostsWithAuthorRow struct {
+	ID    int64
+	Title string
+	User  User
+}
+
+func (q *Queries) ListPostsWithAuthor(ctx context.Context) ([]ListPostsWithAuthorRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listPostsWithAuthor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsWithAuthorRow
+	for rows.Next() {
+		var i ListPostsWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Bio,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
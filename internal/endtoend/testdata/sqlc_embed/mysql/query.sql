@@ -0,0 +1,19 @@
+CREATE TABLE users (
+  id   bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name text NOT NULL,
+  bio  text
+);
+
+CREATE TABLE posts (
+  id      bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  user_id bigint NOT NULL,
+  title   text NOT NULL
+);
+
+-- name: GetUser :one
+SELECT sqlc.embed(users) FROM users WHERE id = ?;
+
+-- name: ListPostsWithAuthor :many
+SELECT p.id, p.title, sqlc.embed(u)
+FROM posts p
+JOIN users u ON u.id = p.user_id;
@@ -0,0 +1,15 @@
+CREATE TABLE authors (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE books (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    author_id bigint NOT NULL,
+    title text NOT NULL
+);
+
+-- name: ListAuthorsWithBooks :many
+SELECT sqlc.embed(authors), sqlc.embed(books)
+FROM authors
+LEFT JOIN books ON books.author_id = authors.id;
@@ -0,0 +1,50 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listUsersWithManager = `-- name: ListUsersWithManager :many
+SELECT u.id, u.name, u.manager_id, m.id, m.name, m.manager_id
+FROM users u
+JOIN users m ON m.id = u.manager_id
+`
+
+type ListUsersWithManagerRow struct {
+	User   User `db:"user" json:"user"`
+	User_2 User `db:"user_2" json:"user_2"`
+}
+
+func (q *Queries) ListUsersWithManager(ctx context.Context) ([]ListUsersWithManagerRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, listUsersWithManager)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersWithManagerRow
+	for rows.Next() {
+		var i ListUsersWithManagerRow
+		if err := rows.Scan(
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.ManagerID,
+			&i.User_2.ID,
+			&i.User_2.Name,
+			&i.User_2.ManagerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
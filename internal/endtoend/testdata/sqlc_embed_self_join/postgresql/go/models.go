@@ -0,0 +1,12 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import ()
+
+type User struct {
+	// Default: auto increment
+	ID        int64  `db:"id" json:"id"`
+	Name      string `db:"name" json:"name"`
+	ManagerID int64  `db:"manager_id" json:"manager_id"`
+}
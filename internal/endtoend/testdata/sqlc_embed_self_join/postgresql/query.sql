@@ -0,0 +1,10 @@
+CREATE TABLE users (
+  id         BIGSERIAL PRIMARY KEY,
+  name       text NOT NULL,
+  manager_id bigint NOT NULL
+);
+
+-- name: ListUsersWithManager :many
+SELECT sqlc.embed(u), sqlc.embed(m)
+FROM users u
+JOIN users m ON m.id = u.manager_id;
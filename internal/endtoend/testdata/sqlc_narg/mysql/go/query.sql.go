@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name, status, bio FROM authors
+WHERE (? IS NULL OR status = ?)
+  AND bio = ?
+`
+
+type ListAuthorsParams struct {
+	Status sql.NullString
+
+	Bio string
+}
+
+func (q *Queries) ListAuthors(ctx context.Context, arg ListAuthorsParams) ([]Author, error) {
+
+	listAuthors := listAuthors
+
+	rows, err := q.db.QueryContext(ctx, listAuthors, arg.Status, arg.Status, arg.Bio)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.Bio,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsByName = `-- name: ListAuthorsByName :many
+SELECT id, name, status, bio FROM authors
+WHERE name = ? OR bio = ?
+`
+
+func (q *Queries) ListAuthorsByName(ctx context.Context, name string) ([]Author, error) {
+
+	rows, err := q.db.QueryContext(ctx, listAuthorsByName, name, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.Bio,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
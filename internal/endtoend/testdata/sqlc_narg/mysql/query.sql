@@ -0,0 +1,15 @@
+CREATE TABLE authors (
+  id     bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name   text NOT NULL,
+  status text NOT NULL,
+  bio    text
+);
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'))
+  AND bio = sqlc.arg('bio')::not null;
+
+-- name: ListAuthorsByName :many
+SELECT * FROM authors
+WHERE name = sqlc.arg(name) OR bio = sqlc.arg(name);
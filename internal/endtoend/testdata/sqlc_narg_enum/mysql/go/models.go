@@ -0,0 +1,71 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type UsersStatus string
+
+const (
+	UsersStatusActive UsersStatus = "active"
+	UsersStatusBanned UsersStatus = "banned"
+)
+
+func (e *UsersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UsersStatus(s)
+	case string:
+		*e = UsersStatus(s)
+
+	default:
+		return fmt.Errorf("unsupported scan type for UsersStatus: %T", src)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e UsersStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// Valid reports whether e is one of the values of UsersStatus.
+func (e UsersStatus) Valid() bool {
+	switch e {
+	case UsersStatusActive, UsersStatusBanned:
+		return true
+	}
+	return false
+}
+
+type NullUsersStatus struct {
+	UsersStatus UsersStatus
+	Valid       bool // Valid is true if UsersStatus is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (ns *NullUsersStatus) Scan(src interface{}) error {
+	if src == nil {
+		ns.UsersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UsersStatus.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (ns NullUsersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return ns.UsersStatus.Value()
+}
+
+type User struct {
+	// Default: auto increment
+	ID     int64
+	Status UsersStatus
+}
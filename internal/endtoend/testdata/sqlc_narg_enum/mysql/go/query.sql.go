@@ -0,0 +1,55 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, status FROM users
+WHERE ? IS NULL OR status = ?
+`
+
+func (q *Queries) ListUsers(ctx context.Context, status NullUsersStatus) ([]User, error) {
+
+	rows, err := q.db.QueryContext(ctx, listUsers, status, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateStatus = `-- name: UpdateStatus :exec
+UPDATE users SET status = ? WHERE id = ?
+`
+
+type UpdateStatusParams struct {
+	Status UsersStatus
+
+	ID int64
+}
+
+func (q *Queries) UpdateStatus(ctx context.Context, arg UpdateStatusParams) error {
+
+	updateStatus := updateStatus
+
+	_, err := q.db.ExecContext(ctx, updateStatus, arg.Status, arg.ID)
+	return err
+}
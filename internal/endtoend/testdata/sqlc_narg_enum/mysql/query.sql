@@ -0,0 +1,11 @@
+CREATE TABLE users (
+  id     bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  status ENUM('active', 'banned') NOT NULL
+);
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE sqlc.narg(status) IS NULL OR status = sqlc.narg(status);
+
+-- name: UpdateStatus :exec
+UPDATE users SET status = sqlc.arg(status) WHERE id = sqlc.arg(id);
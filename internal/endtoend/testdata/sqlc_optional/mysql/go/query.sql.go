@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+const searchAuthors = `-- name: SearchAuthors :many
+SELECT id, name, bio FROM authors
+WHERE id > ?
+  AND /* sqlc.optional:1 */ (name = ?)
+  AND /* sqlc.optional:2 */ (bio = ? OR name = ?)
+`
+
+type SearchAuthorsParams struct {
+	MinID int64
+
+	Name sql.NullString
+
+	Bio sql.NullString
+}
+
+func (q *Queries) SearchAuthors(ctx context.Context, arg SearchAuthorsParams) ([]Author, error) {
+	query := searchAuthors
+	var args []interface{}
+	args = append(args, arg.MinID)
+	if arg.Name.Valid {
+		args = append(args, arg.Name)
+	} else {
+		query = strings.Replace(query, "/* sqlc.optional:1 */ (name = ?)", "TRUE", 1)
+	}
+	if arg.Bio.Valid {
+		args = append(args, arg.Bio, arg.Bio)
+	} else {
+		query = strings.Replace(query, "/* sqlc.optional:2 */ (bio = ? OR name = ?)", "TRUE", 1)
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+const searchAuthors = `-- name: SearchAuthors :many
+SELECT id, name, bio FROM authors
+WHERE id > $1
+  AND /* sqlc.optional:1 */ (name = $2)
+  AND /* sqlc.optional:2 */ (bio = $3 OR name = $3)
+`
+
+type SearchAuthorsParams struct {
+	MinID int64
+
+	Name sql.NullString
+
+	Bio sql.NullString
+}
+
+func (q *Queries) SearchAuthors(ctx context.Context, arg SearchAuthorsParams) ([]Author, error) {
+	query := searchAuthors
+	var args []interface{}
+	args = append(args, arg.MinID)
+	args = append(args, arg.Name)
+	if !arg.Name.Valid {
+		query = strings.Replace(query, "/* sqlc.optional:1 */ (name = $2)", "(TRUE OR name = $2)", 1)
+	}
+	args = append(args, arg.Bio)
+	if !arg.Bio.Valid {
+		query = strings.Replace(query, "/* sqlc.optional:2 */ (bio = $3 OR name = $3)", "(TRUE OR bio = $3 OR name = $3)", 1)
+	}
+
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,11 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text NOT NULL,
+  bio  text
+);
+
+-- name: SearchAuthors :many
+SELECT * FROM authors
+WHERE id > sqlc.arg(min_id)
+  AND sqlc.optional(name = sqlc.arg(name))
+  AND sqlc.optional(bio = sqlc.arg(bio) OR name = sqlc.arg(bio));
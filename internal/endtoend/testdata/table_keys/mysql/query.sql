@@ -0,0 +1,24 @@
+CREATE TABLE authors (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    email varchar(255) NOT NULL,
+    UNIQUE KEY (email)
+);
+
+CREATE TABLE books (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    author_id bigint NOT NULL,
+    isbn varchar(13),
+    FOREIGN KEY (author_id) REFERENCES authors (id)
+);
+
+ALTER TABLE books ADD UNIQUE (isbn);
+ALTER TABLE books ADD INDEX (isbn);
+ALTER TABLE books ADD CONSTRAINT FOREIGN KEY (author_id) REFERENCES authors (id);
+
+-- Unnamed keys are named like MySQL names them
+DROP INDEX isbn_2 ON books;
+ALTER TABLE books DROP FOREIGN KEY books_ibfk_2;
+CREATE INDEX books_ibfk_2 ON books (author_id);
+
+-- name: GetBookByISBN :one
+SELECT id, author_id, isbn FROM books WHERE isbn = ?;
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getBookByISBN = `-- name: GetBookByISBN :one
+SELECT id, author_id, isbn FROM books WHERE isbn = $1
+`
+
+func (q *Queries) GetBookByISBN(ctx context.Context, isbn sql.NullString) (Book, error) {
+
+	row := q.db.QueryRowContext(ctx, getBookByISBN, isbn)
+	var i Book
+	err := row.Scan(&i.ID, &i.AuthorID, &i.Isbn)
+	return i, err
+}
@@ -0,0 +1,20 @@
+CREATE TABLE authors (
+    id BIGSERIAL PRIMARY KEY,
+    email text NOT NULL UNIQUE
+);
+
+CREATE TABLE books (
+    id BIGSERIAL PRIMARY KEY,
+    author_id bigint NOT NULL REFERENCES authors,
+    isbn text
+);
+
+ALTER TABLE books ADD UNIQUE (isbn);
+CREATE INDEX ON books (isbn);
+
+-- Unnamed keys are named like PostgreSQL names them
+DROP INDEX books_isbn_idx;
+ALTER TABLE books DROP CONSTRAINT books_author_id_fkey;
+
+-- name: GetBookByISBN :one
+SELECT id, author_id, isbn FROM books WHERE isbn = $1;
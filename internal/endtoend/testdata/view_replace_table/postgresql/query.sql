@@ -0,0 +1,10 @@
+CREATE TABLE users (
+    id bigint NOT NULL,
+    name text NOT NULL
+);
+
+CREATE OR REPLACE VIEW users AS
+SELECT id FROM users;
+
+-- name: ListUsers :many
+SELECT id FROM users;
@@ -0,0 +1,76 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const getActiveUser = `-- name: GetActiveUser :one
+SELECT user_id, user_name, user_email FROM active_users WHERE user_id = $1
+`
+
+func (q *Queries) GetActiveUser(ctx context.Context, userID int64) (ActiveUser, error) {
+
+	row := q.db.QueryRowContext(ctx, getActiveUser, userID)
+	var i ActiveUser
+	err := row.Scan(&i.UserID, &i.UserName, &i.UserEmail)
+	return i, err
+}
+
+const listActiveUsers = `-- name: ListActiveUsers :many
+SELECT user_id, user_name, user_email FROM active_users ORDER BY user_name
+`
+
+func (q *Queries) ListActiveUsers(ctx context.Context) ([]ActiveUser, error) {
+
+	rows, err := q.db.QueryContext(ctx, listActiveUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActiveUser
+	for rows.Next() {
+		var i ActiveUser
+		if err := rows.Scan(&i.UserID, &i.UserName, &i.UserEmail); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserCounts = `-- name: ListUserCounts :many
+SELECT active, total FROM user_counts
+`
+
+func (q *Queries) ListUserCounts(ctx context.Context) ([]UserCount, error) {
+
+	rows, err := q.db.QueryContext(ctx, listUserCounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserCount
+	for rows.Next() {
+		var i UserCount
+		if err := rows.Scan(&i.Active, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,24 @@
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL,
+    email text,
+    active boolean NOT NULL
+);
+
+CREATE VIEW active_users AS
+SELECT id, name, email FROM users WHERE active;
+
+CREATE OR REPLACE VIEW active_users (user_id, user_name, user_email) AS
+SELECT id, name, email FROM users WHERE active;
+
+CREATE MATERIALIZED VIEW user_counts AS
+SELECT active, COUNT(*) AS total FROM users GROUP BY active;
+
+-- name: ListActiveUsers :many
+SELECT user_id, user_name, user_email FROM active_users ORDER BY user_name;
+
+-- name: GetActiveUser :one
+SELECT * FROM active_users WHERE user_id = $1;
+
+-- name: ListUserCounts :many
+SELECT active, total FROM user_counts;
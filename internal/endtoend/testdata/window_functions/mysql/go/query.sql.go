@@ -0,0 +1,92 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const movingAverage = `-- name: MovingAverage :many
+SELECT id, AVG(points) OVER (ORDER BY id ROWS BETWEEN ? PRECEDING AND CURRENT ROW) AS average
+FROM scores
+`
+
+type MovingAverageRow struct {
+	ID      int64
+	Average interface{}
+}
+
+func (q *Queries) MovingAverage(ctx context.Context, frameStart int64) ([]MovingAverageRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, movingAverage, frameStart)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MovingAverageRow
+	for rows.Next() {
+		var i MovingAverageRow
+		if err := rows.Scan(&i.ID, &i.Average); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rankScores = `-- name: RankScores :many
+SELECT
+    player,
+    ROW_NUMBER() OVER (ORDER BY points DESC) AS position,
+    RANK() OVER (PARTITION BY player ORDER BY points DESC) AS player_rank,
+    SUM(points) OVER (PARTITION BY player ORDER BY id ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS running_total,
+    LAG(points) OVER w AS previous_points
+FROM scores
+WINDOW w AS (PARTITION BY player ORDER BY id)
+`
+
+type RankScoresRow struct {
+	Player         string
+	Position       int64
+	PlayerRank     int64
+	RunningTotal   sql.NullInt32
+	PreviousPoints interface{}
+}
+
+func (q *Queries) RankScores(ctx context.Context) ([]RankScoresRow, error) {
+
+	rows, err := q.db.QueryContext(ctx, rankScores)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankScoresRow
+	for rows.Next() {
+		var i RankScoresRow
+		if err := rows.Scan(
+			&i.Player,
+			&i.Position,
+			&i.PlayerRank,
+			&i.RunningTotal,
+			&i.PreviousPoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,20 @@
+CREATE TABLE scores (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    player text NOT NULL,
+    points int NOT NULL,
+    bonus int
+);
+
+-- name: RankScores :many
+SELECT
+    player,
+    ROW_NUMBER() OVER (ORDER BY points DESC) AS position,
+    RANK() OVER (PARTITION BY player ORDER BY points DESC) AS player_rank,
+    SUM(points) OVER (PARTITION BY player ORDER BY id ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS running_total,
+    LAG(points) OVER w AS previous_points
+FROM scores
+WINDOW w AS (PARTITION BY player ORDER BY id);
+
+-- name: MovingAverage :many
+SELECT id, AVG(points) OVER (ORDER BY id ROWS BETWEEN ? PRECEDING AND CURRENT ROW) AS average
+FROM scores;
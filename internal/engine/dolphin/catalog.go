@@ -12,5 +12,6 @@ func NewCatalog() *catalog.Catalog {
 			defaultSchema(def),
 		},
 		Extensions: map[string]struct{}{},
+		KeyName:    keyName,
 	}
 }
@@ -3,9 +3,12 @@ package dolphin
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	pcast "github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/charset"
+	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/parser/opcode"
 	driver "github.com/pingcap/parser/test_driver"
 	"github.com/pingcap/parser/types"
@@ -18,6 +21,7 @@ import (
 type cc struct {
 	paramCount       int
 	currentTableName string
+	userVariables    bool
 }
 
 func todo(n pcast.Node) *ast.TODO {
@@ -39,8 +43,13 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				name := def.Name.OrigColName() // def.Name.String()
 				columnDef := ast.ColumnDef{
 					Colname:   def.Name.OrigColName(), // def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
+					TypeName:  columnType(def.Tp),
 					IsNotNull: isNotNull(def),
+					Vals:      columnVals(def.Tp),
+
+					Default:         defaultExpr(def),
+					Generated:       generatedExpr(def),
+					IsAutoIncrement: isAutoIncrement(def),
 				}
 				if def.Tp.Flen >= 0 {
 					length := def.Tp.Flen
@@ -69,8 +78,13 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				name := def.Name.OrigColName() //def.Name.String()
 				columnDef := ast.ColumnDef{
 					Colname:   def.Name.OrigColName(), //def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
+					TypeName:  columnType(def.Tp),
 					IsNotNull: isNotNull(def),
+					Vals:      columnVals(def.Tp),
+
+					Default:         defaultExpr(def),
+					Generated:       generatedExpr(def),
+					IsAutoIncrement: isAutoIncrement(def),
 				}
 				if def.Tp.Flen >= 0 {
 					length := def.Tp.Flen
@@ -79,6 +93,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_DropColumn,
+					Modify:  true,
 				})
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
@@ -91,7 +106,32 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			// 	spew.Dump("alter column", spec)
 
 		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+			con := c.convertTableConstraint(spec.Constraint)
+			if con == nil {
+				continue
+			}
+			// The name of an unnamed key depends on the keys the table
+			// already has, so the catalog names it.
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:       &con.Name,
+				Subtype:    ast.AT_AddConstraint,
+				Constraint: con,
+			})
+
+		case pcast.AlterTableDropPrimaryKey:
+			name := "PRIMARY"
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
+
+		case pcast.AlterTableDropIndex, pcast.AlterTableDropForeignKey:
+			name := spec.Name
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:      &name,
+				Subtype:   ast.AT_DropConstraint,
+				MissingOk: spec.IfExists,
+			})
 
 		case pcast.AlterTableRenameColumn:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -123,8 +163,9 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 func (c *cc) convertAssignment(n *pcast.Assignment) *ast.ResTarget {
 	name := n.Column.Name.String()
 	return &ast.ResTarget{
-		Name: &name,
-		Val:  c.convert(n.Expr),
+		Name:      &name,
+		Val:       c.convert(n.Expr),
+		TableName: n.Column.Table.String(),
 	}
 }
 
@@ -132,7 +173,8 @@ func (c *cc) convertAssignment(n *pcast.Assignment) *ast.ResTarget {
 func opToName(o opcode.Op) string {
 	switch o {
 	// case opcode.And:
-	// case opcode.BitNeg:
+	case opcode.BitNeg:
+		return "~"
 	// case opcode.Case:
 	// case opcode.Div:
 	case opcode.EQ:
@@ -218,16 +260,14 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	if n.ReferTable != nil {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
-	for _, def := range n.Cols {
-		var vals *ast.List
-		if len(def.Tp.Elems) > 0 {
-			vals = &ast.List{}
-			for i := range def.Tp.Elems {
-				vals.Items = append(vals.Items, &ast.String{
-					Str: def.Tp.Elems[i],
-				})
-			}
+	for _, con := range n.Constraints {
+		key := c.convertTableConstraint(con)
+		if key == nil {
+			continue
 		}
+		create.Constraints = append(create.Constraints, key)
+	}
+	for _, def := range n.Cols {
 		comment := ""
 		for _, opt := range def.Options {
 			switch opt.Tp {
@@ -235,15 +275,30 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 				if value, ok := opt.Expr.(*driver.ValueExpr); ok {
 					comment = value.GetString()
 				}
+			case pcast.ColumnOptionPrimaryKey:
+				create.Constraints = append(create.Constraints, &ast.TableConstraint{
+					Kind:    ast.ConstraintPrimaryKey,
+					Name:    "PRIMARY",
+					Columns: []string{def.Name.OrigColName()},
+				})
+			case pcast.ColumnOptionUniqKey:
+				create.Constraints = append(create.Constraints, &ast.TableConstraint{
+					Kind:    ast.ConstraintUnique,
+					Columns: []string{def.Name.OrigColName()},
+				})
 			}
 		}
 
 		columnDef := ast.ColumnDef{
 			Colname:   def.Name.OrigColName(), // def.Name.String(),
-			TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
+			TypeName:  columnType(def.Tp),
 			IsNotNull: isNotNull(def),
 			Comment:   comment,
-			Vals:      vals,
+			Vals:      columnVals(def.Tp),
+
+			Default:         defaultExpr(def),
+			Generated:       generatedExpr(def),
+			IsAutoIncrement: isAutoIncrement(def),
 		}
 		if def.Tp.Flen >= 0 {
 			length := def.Tp.Flen
@@ -251,6 +306,7 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 		}
 		create.Cols = append(create.Cols, &columnDef)
 	}
+	nameConstraints(create.Name.Name, create.Constraints)
 	for _, opt := range n.Options {
 		switch opt.Tp {
 		case pcast.TableOptionComment:
@@ -274,6 +330,7 @@ func (c *cc) convertColumnNameExpr(n *pcast.ColumnNameExpr) *ast.ColumnRef {
 			Items: items,
 		},
 		TableName: c.currentTableName,
+		Location:  n.OriginTextPosition(),
 	}
 }
 
@@ -290,27 +347,73 @@ func (c *cc) convertColumnNames(cols []*pcast.ColumnName) *ast.List {
 
 func (c *cc) convertDeleteStmt(n *pcast.DeleteStmt) *ast.DeleteStmt {
 	rels := c.convertTableRefsClause(n.TableRefs)
-	if len(rels.Items) != 1 {
+	tables, quals := joinTables(rels.Items)
+	if len(tables) == 0 {
 		panic("expected one range var")
 	}
-	rel := rels.Items[0]
-	rangeVar, ok := rel.(*ast.RangeVar)
+
+	// DELETE t1 FROM t1 JOIN t2 deletes from the first target and reads the
+	// other tables, like DELETE FROM t1 USING t2 in PostgreSQL. Every target
+	// is kept so the compiler can check that it names a table.
+	target := 0
+	var targets *ast.List
+	if n.IsMultiTable && n.Tables != nil {
+		targets = c.convertDeleteTableList(n.Tables)
+		if len(targets.Items) > 0 {
+			if i := deleteTarget(tables, targets.Items[0].(*ast.RangeVar)); i >= 0 {
+				target = i
+			}
+		}
+	}
+	rangeVar, ok := tables[target].(*ast.RangeVar)
 	if !ok {
 		panic("expected range var")
 	}
+	var using []ast.Node
+	using = append(using, tables[:target]...)
+	using = append(using, tables[target+1:]...)
 
-	return &ast.DeleteStmt{
+	// Parameters are numbered in conversion order
+	stmt := &ast.DeleteStmt{
 		Relation:      rangeVar,
-		WhereClause:   c.convert(n.Where),
+		WhereClause:   whereClause(quals, c.convert(n.Where)),
 		ReturningList: &ast.List{},
+		SortClause:    c.convertOrderByClause(n.Order),
+		Targets:       targets,
+	}
+	if len(using) > 0 {
+		stmt.UsingClause = &ast.List{Items: using}
 	}
+	stmt.LimitCount, _ = c.convertLimit(n.Limit)
+	return stmt
 }
 
-func (c *cc) convertDropTableStmt(n *pcast.DropTableStmt) ast.Node {
-	// TODO: Remove once views are supported.
-	if n.IsView {
-		return todo(n)
+// deleteTarget returns the index of the table a multi-table DELETE removes
+// rows from, or -1 if there is none. The target names a table, or its alias
+// if it has one.
+func deleteTarget(tables []ast.Node, name *ast.RangeVar) int {
+	for i, table := range tables {
+		rv, ok := table.(*ast.RangeVar)
+		if !ok {
+			continue
+		}
+		if rv.Alias != nil && *rv.Alias.Aliasname == *name.Relname {
+			return i
+		}
+	}
+	for i, table := range tables {
+		rv, ok := table.(*ast.RangeVar)
+		if !ok {
+			continue
+		}
+		if *rv.Relname == *name.Relname {
+			return i
+		}
 	}
+	return -1
+}
+
+func (c *cc) convertDropTableStmt(n *pcast.DropTableStmt) ast.Node {
 	drop := &ast.DropTableStmt{IfExists: n.IfExists}
 	for _, name := range n.Tables {
 		drop.Tables = append(drop.Tables, parseTableName(name))
@@ -357,6 +460,17 @@ func (c *cc) convertFuncCallExpr(n *pcast.FuncCallExpr) ast.Node {
 		args.Items = append(args.Items, c.convert(arg))
 	}
 
+	// DATE_ADD(d, INTERVAL n DAY) and d + INTERVAL n DAY are both parsed as
+	// DATE_ADD(d, n, DAY)
+	switch name {
+	case "date_add", "date_sub", "adddate", "subdate":
+		if len(n.Args) == 3 {
+			if unit, ok := n.Args[2].(*pcast.TimeUnitExpr); ok {
+				args.Items[1] = intervalQuantity(args.Items[1], unit.Unit)
+			}
+		}
+	}
+
 	if schema == "" && name == "coalesce" {
 		return &ast.CoalesceExpr{
 			Args: args,
@@ -449,15 +563,15 @@ func (c *cc) convertSelectField(n *pcast.SelectField) *ast.ResTarget {
 
 func (c *cc) convertSelectStmt(n *pcast.SelectStmt) *ast.SelectStmt {
 	stmt := &ast.SelectStmt{
-		TargetList:  c.convertFieldList(n.Fields),
-		FromClause:  c.convertTableRefsClause(n.From),
-		WhereClause: c.convert(n.Where),
-	}
-
-	if n.Limit != nil {
-		stmt.LimitCount = c.convert(n.Limit.Count)
-		stmt.LimitOffset = c.convert(n.Limit.Offset)
-	}
+		TargetList:   c.convertFieldList(n.Fields),
+		FromClause:   c.convertTableRefsClause(n.From),
+		WhereClause:  c.convert(n.Where),
+		GroupClause:  c.convertGroupByClause(n.GroupBy),
+		HavingClause: c.convertHavingClause(n.Having),
+		WindowClause: c.convertWindowSpecs(n.WindowSpecs),
+		SortClause:   c.convertOrderByClause(n.OrderBy),
+	}
+	stmt.LimitCount, stmt.LimitOffset = c.convertLimit(n.Limit)
 	return stmt
 }
 
@@ -478,16 +592,12 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 		panic("expected one range var")
 	}
 
-	var rangeVar *ast.RangeVar
-	switch rel := rels.Items[0].(type) {
+	// UPDATE t1 JOIN t2 ON ... is stored like UPDATE t1 ... FROM t2 in
+	// PostgreSQL, with the join conditions moved to the WHERE clause
+	tables, quals := joinTables(rels.Items)
 
-	// Special case for joins in updates
-	case *ast.JoinExpr:
-		left, ok := rel.Larg.(*ast.RangeVar)
-		if !ok {
-			panic("expected range var")
-		}
-		rangeVar = left
+	var rangeVar *ast.RangeVar
+	switch rel := tables[0].(type) {
 
 	case *ast.RangeVar:
 		rangeVar = rel
@@ -512,20 +622,38 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 	for _, a := range n.List {
 		list.Items = append(list.Items, c.convertAssignment(a))
 	}
-	return &ast.UpdateStmt{
+	// Parameters are numbered in conversion order
+	stmt := &ast.UpdateStmt{
 		Relation:      rangeVar,
 		TargetList:    list,
-		WhereClause:   c.convert(n.Where),
-		FromClause:    &ast.List{},
+		WhereClause:   whereClause(quals, c.convert(n.Where)),
+		FromClause:    &ast.List{Items: tables[1:]},
 		ReturningList: &ast.List{},
+		SortClause:    c.convertOrderByClause(n.Order),
 	}
+	stmt.LimitCount, _ = c.convertLimit(n.Limit)
+	return stmt
 }
 
 func (c *cc) convertValueExpr(n *driver.ValueExpr) *ast.A_Const {
+	var val ast.Node
+	switch n.Datum.Kind() {
+	case driver.KindNull:
+		val = &ast.Null{}
+	case driver.KindInt64:
+		val = &ast.Integer{Ival: n.Datum.GetInt64()}
+	case driver.KindUint64:
+		val = &ast.Integer{Ival: int64(n.Datum.GetUint64())}
+	case driver.KindFloat32, driver.KindFloat64:
+		val = &ast.Float{Str: strconv.FormatFloat(n.Datum.GetFloat64(), 'g', -1, 64)}
+	case driver.KindMysqlDecimal:
+		val = &ast.Float{Str: n.Datum.GetMysqlDecimal().String()}
+	default:
+		val = &ast.String{Str: n.Datum.GetString()}
+	}
 	return &ast.A_Const{
-		Val: &ast.String{
-			Str: n.Datum.GetString(),
-		},
+		Val:      val,
+		Location: n.OriginTextPosition(),
 	}
 }
 
@@ -608,19 +736,42 @@ func (c *cc) convertBeginStmt(n *pcast.BeginStmt) ast.Node {
 }
 
 func (c *cc) convertBetweenExpr(n *pcast.BetweenExpr) ast.Node {
-	return todo(n)
+	return &ast.BetweenExpr{
+		Expr:     c.convert(n.Expr),
+		Left:     c.convert(n.Left),
+		Right:    c.convert(n.Right),
+		Not:      n.Not,
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertBinlogStmt(n *pcast.BinlogStmt) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertByItem(n *pcast.ByItem) ast.Node {
-	return todo(n)
+func (c *cc) convertByItem(n *pcast.ByItem) *ast.SortBy {
+	dir := ast.SortByDirDefault
+	if n.Desc {
+		dir = ast.SortByDirDesc
+	}
+	return &ast.SortBy{
+		Node:      c.convert(n.Expr),
+		SortbyDir: dir,
+		Location:  n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertCaseExpr(n *pcast.CaseExpr) ast.Node {
-	return todo(n)
+	args := &ast.List{}
+	for _, w := range n.WhenClauses {
+		args.Items = append(args.Items, c.convertWhenClause(w))
+	}
+	return &ast.CaseExpr{
+		Arg:       c.convert(n.Value),
+		Args:      args,
+		Defresult: c.convert(n.ElseClause),
+		Location:  n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertChangeStmt(n *pcast.ChangeStmt) ast.Node {
@@ -652,7 +803,36 @@ func (c *cc) convertCompareSubqueryExpr(n *pcast.CompareSubqueryExpr) ast.Node {
 }
 
 func (c *cc) convertConstraint(n *pcast.Constraint) ast.Node {
-	return todo(n)
+	if con := c.convertTableConstraint(n); con != nil {
+		return con
+	}
+	return todo(n)
+}
+
+// convertTableConstraint converts the keys, indexes and foreign keys of a
+// table. CHECK constraints are not tracked and return nil.
+func (c *cc) convertTableConstraint(n *pcast.Constraint) *ast.TableConstraint {
+	con := &ast.TableConstraint{Name: n.Name}
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey:
+		con.Kind = ast.ConstraintPrimaryKey
+		con.Name = "PRIMARY"
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		con.Kind = ast.ConstraintUnique
+	case pcast.ConstraintKey, pcast.ConstraintIndex, pcast.ConstraintFulltext:
+		con.Kind = ast.ConstraintIndex
+	case pcast.ConstraintForeignKey:
+		con.Kind = ast.ConstraintForeignKey
+		if n.Refer == nil {
+			return nil
+		}
+		con.RefTable = parseTableName(n.Refer.Table)
+		con.RefColumns = indexColumns(n.Refer.IndexPartSpecifications)
+	default:
+		return nil
+	}
+	con.Columns = indexColumns(n.Keys)
+	return con
 }
 
 func (c *cc) convertCreateBindingStmt(n *pcast.CreateBindingStmt) ast.Node {
@@ -667,7 +847,18 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	params := &ast.List{}
+	for _, name := range indexColumns(n.IndexPartSpecifications) {
+		name := name
+		params.Items = append(params.Items, &ast.IndexElem{Name: &name})
+	}
+	return &ast.IndexStmt{
+		Idxname:     &n.IndexName,
+		Relation:    c.convertTableName(n.Table),
+		IndexParams: params,
+		Unique:      n.KeyType == pcast.IndexKeyTypeUnique,
+		IfNotExists: n.IfNotExists,
+	}
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
@@ -683,7 +874,19 @@ func (c *cc) convertCreateUserStmt(n *pcast.CreateUserStmt) ast.Node {
 }
 
 func (c *cc) convertCreateViewStmt(n *pcast.CreateViewStmt) ast.Node {
-	return todo(n)
+	var aliases *ast.List
+	if len(n.Cols) > 0 {
+		aliases = &ast.List{}
+		for _, col := range n.Cols {
+			aliases.Items = append(aliases.Items, &ast.String{Str: col.O})
+		}
+	}
+	return &ast.ViewStmt{
+		View:    c.convertTableName(n.ViewName),
+		Aliases: aliases,
+		Query:   c.convert(n.Select),
+		Replace: n.OrReplace,
+	}
 }
 
 func (c *cc) convertDeallocateStmt(n *pcast.DeallocateStmt) ast.Node {
@@ -691,11 +894,19 @@ func (c *cc) convertDeallocateStmt(n *pcast.DeallocateStmt) ast.Node {
 }
 
 func (c *cc) convertDefaultExpr(n *pcast.DefaultExpr) ast.Node {
-	return todo(n)
+	// DEFAULT(col) reads the default of another column and is not supported.
+	if n.Name != nil {
+		return todo(n)
+	}
+	return &ast.SetToDefault{}
 }
 
-func (c *cc) convertDeleteTableList(n *pcast.DeleteTableList) ast.Node {
-	return todo(n)
+func (c *cc) convertDeleteTableList(n *pcast.DeleteTableList) *ast.List {
+	list := &ast.List{}
+	for _, table := range n.Tables {
+		list.Items = append(list.Items, c.convertTableName(table))
+	}
+	return list
 }
 
 func (c *cc) convertDoStmt(n *pcast.DoStmt) ast.Node {
@@ -716,7 +927,11 @@ func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertDropIndexStmt(n *pcast.DropIndexStmt) ast.Node {
-	return todo(n)
+	return &ast.DropIndexStmt{
+		IfExists: n.IfExists,
+		Table:    parseTableName(n.Table),
+		Indexes:  []*ast.TableName{{Name: n.IndexName}},
+	}
 }
 
 func (c *cc) convertDropSequenceStmt(n *pcast.DropSequenceStmt) ast.Node {
@@ -755,20 +970,87 @@ func (c *cc) convertFlushStmt(n *pcast.FlushStmt) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertFrameBound(n *pcast.FrameBound) ast.Node {
-	return todo(n)
+// convertFrameBound returns the START frame option of a bound, which is
+// shifted left by one for an END bound, along with its offset.
+func (c *cc) convertFrameBound(n *pcast.FrameBound) (int, ast.Node) {
+	switch {
+	case n.Type == pcast.CurrentRow:
+		return ast.FrameOptionStartCurrentRow, nil
+	case n.UnBounded && n.Type == pcast.Preceding:
+		return ast.FrameOptionStartUnboundedPreceding, nil
+	case n.UnBounded:
+		return ast.FrameOptionStartUnboundedFollowing, nil
+	}
+	offset := c.convert(n.Expr)
+	if n.Unit != pcast.TimeUnitInvalid {
+		offset = intervalQuantity(offset, n.Unit)
+	} else {
+		offset = &ast.TypeCast{
+			Arg:      offset,
+			TypeName: castType("bigint"),
+			Location: offset.Pos(),
+		}
+	}
+	if n.Type == pcast.Preceding {
+		return ast.FrameOptionStartValuePreceding, offset
+	}
+	return ast.FrameOptionStartValueFollowing, offset
 }
 
-func (c *cc) convertFrameClause(n *pcast.FrameClause) ast.Node {
-	return todo(n)
+// convertFrameClause returns a window definition holding only the frame
+// options and offsets of n.
+func (c *cc) convertFrameClause(n *pcast.FrameClause) *ast.WindowDef {
+	if n == nil {
+		return &ast.WindowDef{}
+	}
+	def := &ast.WindowDef{
+		FrameOptions: ast.FrameOptionNonDefault | ast.FrameOptionBetween,
+	}
+	switch n.Type {
+	case pcast.Rows:
+		def.FrameOptions |= ast.FrameOptionRows
+	case pcast.Ranges:
+		def.FrameOptions |= ast.FrameOptionRange
+	}
+	start, startOffset := c.convertFrameBound(&n.Extent.Start)
+	end, endOffset := c.convertFrameBound(&n.Extent.End)
+	def.FrameOptions |= start | end<<1
+	def.StartOffset = startOffset
+	def.EndOffset = endOffset
+	return def
 }
 
 func (c *cc) convertFuncCastExpr(n *pcast.FuncCastExpr) ast.Node {
-	return todo(n)
+	typeName := castType(castTypeName(n.Tp))
+	typeName.Unsigned = mysql.HasUnsignedFlag(n.Tp.Flag)
+	return &ast.TypeCast{
+		Arg:      c.convert(n.Expr),
+		TypeName: typeName,
+		Location: n.OriginTextPosition(),
+	}
+}
+
+// castTypeName returns the name of the type produced by CAST and CONVERT,
+// spelled the way column types are.
+func castTypeName(tp *types.FieldType) string {
+	switch tp.Tp {
+	case mysql.TypeString, mysql.TypeVarString:
+		if tp.Charset == charset.CharsetBin {
+			return "varbinary"
+		}
+		return "varchar"
+	case mysql.TypeFloat:
+		return "double"
+	default:
+		return types.TypeStr(tp.Tp)
+	}
 }
 
 func (c *cc) convertGetFormatSelectorExpr(n *pcast.GetFormatSelectorExpr) ast.Node {
-	return todo(n)
+	return &ast.A_Const{
+		Val:      &ast.String{Str: n.Selector.String()},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertGrantRoleStmt(n *pcast.GrantRoleStmt) ast.Node {
@@ -779,12 +1061,22 @@ func (c *cc) convertGrantStmt(n *pcast.GrantStmt) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertGroupByClause(n *pcast.GroupByClause) ast.Node {
-	return todo(n)
+func (c *cc) convertGroupByClause(n *pcast.GroupByClause) *ast.List {
+	if n == nil {
+		return nil
+	}
+	list := &ast.List{}
+	for _, item := range n.Items {
+		list.Items = append(list.Items, c.convert(item.Expr))
+	}
+	return list
 }
 
 func (c *cc) convertHavingClause(n *pcast.HavingClause) ast.Node {
-	return todo(n)
+	if n == nil {
+		return nil
+	}
+	return c.convert(n.Expr)
 }
 
 func (c *cc) convertIndexAdviseStmt(n *pcast.IndexAdviseStmt) ast.Node {
@@ -800,7 +1092,15 @@ func (c *cc) convertIndexPartSpecification(n *pcast.IndexPartSpecification) ast.
 }
 
 func (c *cc) convertIsNullExpr(n *pcast.IsNullExpr) ast.Node {
-	return todo(n)
+	op := ast.NullTestTypeIsNull
+	if n.Not {
+		op = ast.NullTestTypeIsNotNull
+	}
+	return &ast.NullTest{
+		Arg:          c.convert(n.Expr),
+		Nulltesttype: op,
+		Location:     n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertIsTruthExpr(n *pcast.IsTruthExpr) ast.Node {
@@ -817,9 +1117,10 @@ func (c *cc) convertJoin(n *pcast.Join) *ast.List {
 		}
 		return &ast.List{
 			Items: []ast.Node{&ast.JoinExpr{
-				Larg:  c.convert(n.Left),
-				Rarg:  c.convert(n.Right),
-				Quals: c.convert(n.On),
+				Jointype: joinType(n.Tp),
+				Larg:     c.convert(n.Left),
+				Rarg:     c.convert(n.Right),
+				Quals:    c.convert(n.On),
 			}},
 		}
 	}
@@ -837,6 +1138,47 @@ func (c *cc) convertJoin(n *pcast.Join) *ast.List {
 	return &ast.List{Items: tables}
 }
 
+// joinTables flattens the tables of a FROM clause and returns them together
+// with the conditions of the joins between them, in source order.
+func joinTables(items []ast.Node) ([]ast.Node, []ast.Node) {
+	var tables, quals []ast.Node
+	for _, item := range items {
+		switch n := item.(type) {
+		case *ast.List:
+			t, q := joinTables(n.Items)
+			tables = append(tables, t...)
+			quals = append(quals, q...)
+		case *ast.JoinExpr:
+			t, q := joinTables([]ast.Node{n.Larg, n.Rarg})
+			tables = append(tables, t...)
+			quals = append(quals, q...)
+			if n.Quals != nil {
+				quals = append(quals, n.Quals)
+			}
+		default:
+			tables = append(tables, item)
+		}
+	}
+	return tables, quals
+}
+
+// whereClause combines join conditions and a WHERE clause with AND.
+func whereClause(quals []ast.Node, where ast.Node) ast.Node {
+	if where != nil {
+		quals = append(quals, where)
+	}
+	switch len(quals) {
+	case 0:
+		return nil
+	case 1:
+		return quals[0]
+	}
+	return &ast.BoolExpr{
+		Boolop: ast.BoolExprTypeAnd,
+		Args:   &ast.List{Items: quals},
+	}
+}
+
 func (c *cc) convertCurrentTableName(n *pcast.TableSource) {
 
 	if tn, ok := n.Source.(*pcast.TableName); ok {
@@ -849,8 +1191,13 @@ func (c *cc) convertKillStmt(n *pcast.KillStmt) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertLimit(n *pcast.Limit) ast.Node {
-	return todo(n)
+// convertLimit returns the row count and offset of a LIMIT clause. The shared
+// AST stores them on the statement, so a LIMIT clause has no node of its own.
+func (c *cc) convertLimit(n *pcast.Limit) (ast.Node, ast.Node) {
+	if n == nil {
+		return nil, nil
+	}
+	return c.convert(n.Count), c.convert(n.Offset)
 }
 
 func (c *cc) convertLoadDataStmt(n *pcast.LoadDataStmt) ast.Node {
@@ -888,8 +1235,15 @@ func (c *cc) convertOnUpdateOpt(n *pcast.OnUpdateOpt) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertOrderByClause(n *pcast.OrderByClause) ast.Node {
-	return todo(n)
+func (c *cc) convertOrderByClause(n *pcast.OrderByClause) *ast.List {
+	if n == nil {
+		return nil
+	}
+	list := &ast.List{}
+	for _, item := range n.Items {
+		list.Items = append(list.Items, c.convertByItem(item))
+	}
+	return list
 }
 
 func (c *cc) convertParenthesesExpr(n *pcast.ParenthesesExpr) ast.Node {
@@ -899,8 +1253,15 @@ func (c *cc) convertParenthesesExpr(n *pcast.ParenthesesExpr) ast.Node {
 	return c.convert(n.Expr)
 }
 
-func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) ast.Node {
-	return todo(n)
+func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) *ast.List {
+	if n == nil {
+		return nil
+	}
+	list := &ast.List{}
+	for _, item := range n.Items {
+		list.Items = append(list.Items, c.convert(item.Expr))
+	}
+	return list
 }
 
 func (c *cc) convertPatternInExpr(n *pcast.PatternInExpr) ast.Node {
@@ -944,12 +1305,40 @@ func (c *cc) convertPatternInExpr(n *pcast.PatternInExpr) ast.Node {
 	}
 }
 
+// LIKE and REGEXP use the names of the equivalent PostgreSQL operators. MySQL
+// compares nonbinary strings case-insensitively, which matches ~* rather than ~.
 func (c *cc) convertPatternLikeExpr(n *pcast.PatternLikeExpr) ast.Node {
-	return todo(n)
+	op := "~~"
+	if n.Not {
+		op = "!~~"
+	}
+	return &ast.A_Expr{
+		Name: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: op},
+			},
+		},
+		Lexpr:    c.convert(n.Expr),
+		Rexpr:    c.convert(n.Pattern),
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertPatternRegexpExpr(n *pcast.PatternRegexpExpr) ast.Node {
-	return todo(n)
+	op := "~*"
+	if n.Not {
+		op = "!~*"
+	}
+	return &ast.A_Expr{
+		Name: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: op},
+			},
+		},
+		Lexpr:    c.convert(n.Expr),
+		Rexpr:    c.convert(n.Pattern),
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertPlacementSpec(n *pcast.PlacementSpec) ast.Node {
@@ -1008,12 +1397,74 @@ func (c *cc) convertSetDefaultRoleStmt(n *pcast.SetDefaultRoleStmt) ast.Node {
 	return todo(n)
 }
 
+// convertSetOprSelectList folds the branches of a set operation into a
+// left-deep tree of SelectStmt nodes. Branches are converted in order, so
+// their parameters are numbered as they appear in the query.
 func (c *cc) convertSetOprSelectList(n *pcast.SetOprSelectList) ast.Node {
-	return todo(n)
+	var stmt *ast.SelectStmt
+	for _, sel := range n.Selects {
+		var branch *ast.SelectStmt
+		var op *pcast.SetOprType
+		switch s := sel.(type) {
+		case *pcast.SelectStmt:
+			branch = c.convertSelectStmt(s)
+			op = s.AfterSetOperator
+		case *pcast.SetOprSelectList:
+			list, ok := c.convertSetOprSelectList(s).(*ast.SelectStmt)
+			if !ok {
+				return todo(n)
+			}
+			branch = list
+			op = s.AfterSetOperator
+		default:
+			return todo(n)
+		}
+		if stmt == nil {
+			stmt = branch
+			continue
+		}
+		stmt = &ast.SelectStmt{
+			TargetList: &ast.List{},
+			FromClause: &ast.List{},
+			Op:         ast.Union,
+			Larg:       stmt,
+			Rarg:       branch,
+		}
+		if op != nil {
+			switch *op {
+			case pcast.UnionAll:
+				stmt.All = true
+			case pcast.Except:
+				stmt.Op = ast.Except
+			case pcast.ExceptAll:
+				stmt.Op = ast.Except
+				stmt.All = true
+			case pcast.Intersect:
+				stmt.Op = ast.Intersect
+			case pcast.IntersectAll:
+				stmt.Op = ast.Intersect
+				stmt.All = true
+			}
+		}
+	}
+	if stmt == nil {
+		return todo(n)
+	}
+	return stmt
 }
 
 func (c *cc) convertSetOprStmt(n *pcast.SetOprStmt) ast.Node {
-	return todo(n)
+	stmt, ok := c.convertSetOprSelectList(n.SelectList).(*ast.SelectStmt)
+	if !ok {
+		return todo(n)
+	}
+	if n.OrderBy != nil {
+		stmt.SortClause = c.convertOrderByClause(n.OrderBy)
+	}
+	if n.Limit != nil {
+		stmt.LimitCount, stmt.LimitOffset = c.convertLimit(n.Limit)
+	}
+	return stmt
 }
 
 func (c *cc) convertSetPwdStmt(n *pcast.SetPwdStmt) ast.Node {
@@ -1064,7 +1515,7 @@ func (c *cc) convertTableSource(node *pcast.TableSource) ast.Node {
 	alias := node.AsName.String()
 	switch n := node.Source.(type) {
 
-	case *pcast.SelectStmt:
+	case *pcast.SelectStmt, *pcast.SetOprStmt:
 		rs := &ast.RangeSubselect{
 			Subquery: c.convert(n),
 		}
@@ -1090,7 +1541,24 @@ func (c *cc) convertTableToTable(n *pcast.TableToTable) ast.Node {
 }
 
 func (c *cc) convertTimeUnitExpr(n *pcast.TimeUnitExpr) ast.Node {
-	return todo(n)
+	return &ast.A_Const{
+		Val:      &ast.String{Str: n.Unit.String()},
+		Location: n.OriginTextPosition(),
+	}
+}
+
+// intervalQuantity types the quantity of an INTERVAL expression. Composite
+// units such as DAY_HOUR take a string like '1 12', all others an integer.
+func intervalQuantity(quantity ast.Node, unit pcast.TimeUnitType) ast.Node {
+	name := "bigint"
+	if unit > pcast.TimeUnitYear {
+		name = "varchar"
+	}
+	return &ast.TypeCast{
+		Arg:      quantity,
+		TypeName: castType(name),
+		Location: quantity.Pos(),
+	}
 }
 
 func (c *cc) convertTraceStmt(n *pcast.TraceStmt) ast.Node {
@@ -1108,7 +1576,26 @@ func (c *cc) convertTruncateTableStmt(n *pcast.TruncateTableStmt) *ast.TruncateS
 }
 
 func (c *cc) convertUnaryOperationExpr(n *pcast.UnaryOperationExpr) ast.Node {
-	return todo(n)
+	if n.Op == opcode.Not {
+		return &ast.BoolExpr{
+			Boolop: ast.BoolExprTypeNot,
+			Args: &ast.List{
+				Items: []ast.Node{
+					c.convert(n.V),
+				},
+			},
+			Location: n.OriginTextPosition(),
+		}
+	}
+	return &ast.A_Expr{
+		Name: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: opToName(n.Op)},
+			},
+		},
+		Rexpr:    c.convert(n.V),
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertUnlockTablesStmt(n *pcast.UnlockTablesStmt) ast.Node {
@@ -1139,19 +1626,89 @@ func (c *cc) convertVariableAssignment(n *pcast.VariableAssignment) ast.Node {
 }
 
 func (c *cc) convertVariableExpr(n *pcast.VariableExpr) ast.Node {
-	return todo(n)
+	// 用户变量 @name 作为命名参数处理，与 PostgreSQL 的 @name 保持一致
+	if n.IsSystem || n.Value != nil || c.userVariables {
+		return todo(n)
+	}
+	return &ast.A_Expr{
+		Name: &ast.List{Items: []ast.Node{&ast.String{Str: "@"}}},
+		Rexpr: &ast.ColumnRef{
+			Fields: &ast.List{Items: []ast.Node{&ast.String{Str: n.Name}}},
+		},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertWhenClause(n *pcast.WhenClause) ast.Node {
-	return todo(n)
+	return &ast.CaseWhen{
+		Expr:     c.convert(n.Expr),
+		Result:   c.convert(n.Result),
+		Location: n.OriginTextPosition(),
+	}
 }
 
-func (c *cc) convertWindowFuncExpr(n *pcast.WindowFuncExpr) ast.Node {
-	return todo(n)
+func (c *cc) convertWindowFuncExpr(n *pcast.WindowFuncExpr) *ast.FuncCall {
+	name := strings.ToLower(n.F)
+	fn := &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: name,
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{
+				&ast.String{
+					Str: name,
+				},
+			},
+		},
+		Args:        &ast.List{},
+		AggDistinct: n.Distinct,
+		Location:    n.OriginTextPosition(),
+	}
+	for _, a := range n.Args {
+		if value, ok := a.(*driver.ValueExpr); ok && name == "count" {
+			if value.GetInt64() == int64(1) {
+				fn.AggStar = true
+				continue
+			}
+		}
+		fn.Args.Items = append(fn.Args.Items, c.convert(a))
+	}
+	fn.Over = c.convertWindowSpec(&n.Spec)
+	return fn
 }
 
-func (c *cc) convertWindowSpec(n *pcast.WindowSpec) ast.Node {
-	return todo(n)
+// convertWindowSpec converts both OVER clauses and the windows of a WINDOW
+// clause. OVER w names a window, while OVER (w ...) refines it.
+func (c *cc) convertWindowSpec(n *pcast.WindowSpec) *ast.WindowDef {
+	def := &ast.WindowDef{
+		PartitionClause: c.convertPartitionByClause(n.PartitionBy),
+		OrderClause:     c.convertOrderByClause(n.OrderBy),
+		Location:        n.OriginTextPosition(),
+	}
+	if name := n.Name.String(); name != "" {
+		def.Name = &name
+	}
+	if ref := n.Ref.String(); ref != "" {
+		def.Refname = &ref
+	}
+	if n.Frame != nil {
+		frame := c.convertFrameClause(n.Frame)
+		def.FrameOptions = frame.FrameOptions
+		def.StartOffset = frame.StartOffset
+		def.EndOffset = frame.EndOffset
+	}
+	return def
+}
+
+func (c *cc) convertWindowSpecs(specs []pcast.WindowSpec) *ast.List {
+	if len(specs) == 0 {
+		return nil
+	}
+	list := &ast.List{}
+	for i := range specs {
+		list.Items = append(list.Items, c.convertWindowSpec(&specs[i]))
+	}
+	return list
 }
 
 func (c *cc) convert(node pcast.Node) ast.Node {
@@ -1325,7 +1882,8 @@ func (c *cc) convert(node pcast.Node) ast.Node {
 		return c.convertFlushStmt(n)
 
 	case *pcast.FrameBound:
-		return c.convertFrameBound(n)
+		// Converted by the frame clause holding the bound
+		return todo(n)
 
 	case *pcast.FrameClause:
 		return c.convertFrameClause(n)
@@ -1376,7 +1934,8 @@ func (c *cc) convert(node pcast.Node) ast.Node {
 		return c.convertKillStmt(n)
 
 	case *pcast.Limit:
-		return c.convertLimit(n)
+		// Converted by the statements holding the clause
+		return todo(n)
 
 	case *pcast.LoadDataStmt:
 		return c.convertLoadDataStmt(n)
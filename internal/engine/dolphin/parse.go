@@ -61,7 +61,7 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	}
 	var stmts []ast.Statement
 	for i := range stmtNodes {
-		converter := &cc{}
+		converter := &cc{userVariables: usesUserVariables(stmtNodes[i])}
 		out := converter.convert(stmtNodes[i])
 		if _, ok := out.(*ast.TODO); ok {
 			continue
@@ -92,6 +92,51 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "date"},
 		},
+		{
+			Name: "ADDDATE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "date"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "date"},
+		},
+		{
+			Name: "ADDDATE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "datetime"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
+		{
+			Name: "ADDDATE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "timestamp"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
 		{
 			Name: "ADDTIME",
 			Args: []*catalog.Argument{
@@ -211,7 +256,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "double"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double"},
+			ReturnType:        &ast.TypeName{Name: "double"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "AVG",
@@ -220,7 +266,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "BENCHMARK",
@@ -516,9 +563,10 @@ func defaultSchema(name string) *catalog.Schema {
 			ReturnType: &ast.TypeName{Name: "double precision"},
 		},
 		{
-			Name:       "COUNT",
-			Args:       []*catalog.Argument{},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			Name:              "COUNT",
+			Args:              []*catalog.Argument{},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.NeverNull,
 		},
 		{
 			Name: "COUNT",
@@ -527,7 +575,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.NeverNull,
 		},
 		{
 			Name: "CRC32",
@@ -643,6 +692,51 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "date"},
 		},
+		{
+			Name: "DATE_ADD",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "date"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "date"},
+		},
+		{
+			Name: "DATE_ADD",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "datetime"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
+		{
+			Name: "DATE_ADD",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "timestamp"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
 		{
 			Name: "DATE_ADD_INTERVAL",
 			Args: []*catalog.Argument{
@@ -679,6 +773,51 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "date"},
 		},
+		{
+			Name: "DATE_SUB",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "date"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "date"},
+		},
+		{
+			Name: "DATE_SUB",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "datetime"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
+		{
+			Name: "DATE_SUB",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "timestamp"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
 		{
 			Name: "DATE_SUB_INTERVAL",
 			Args: []*catalog.Argument{
@@ -757,7 +896,7 @@ func defaultSchema(name string) *catalog.Schema {
 		{
 			Name:       "DENSE_RANK",
 			Args:       []*catalog.Argument{},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType: &ast.TypeName{Name: "bigint"},
 		},
 		{
 			Name: "DISTINCT",
@@ -1074,7 +1213,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "GTID_SUBSET",
@@ -1157,7 +1297,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1169,7 +1310,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "int"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType:        &ast.TypeName{Name: "int"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1181,7 +1323,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "double"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double"},
+			ReturnType:        &ast.TypeName{Name: "double"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		//"varchar", "text", "char", "tinytext", "mediumtext", "longtext":
 		{
@@ -1194,7 +1337,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "varchar"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "varchar"},
+			ReturnType:        &ast.TypeName{Name: "varchar"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1206,7 +1350,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "text"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "text"},
+			ReturnType:        &ast.TypeName{Name: "text"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1218,7 +1363,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "char"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "char"},
+			ReturnType:        &ast.TypeName{Name: "char"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1230,7 +1376,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "tinytext"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "tinytext"},
+			ReturnType:        &ast.TypeName{Name: "tinytext"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1242,7 +1389,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "mediumtext"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "mediumtext"},
+			ReturnType:        &ast.TypeName{Name: "mediumtext"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1254,7 +1402,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "longtext"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "longtext"},
+			ReturnType:        &ast.TypeName{Name: "longtext"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1266,7 +1415,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "enum"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "enum"},
+			ReturnType:        &ast.TypeName{Name: "enum"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "IFNULL",
@@ -1278,7 +1428,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.NullIfAllArgsNull,
 		},
 		{
 			Name: "INET6_ATON",
@@ -1369,7 +1520,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bool"},
+			ReturnType:        &ast.TypeName{Name: "bool"},
+			ReturnNullability: catalog.NeverNull,
 		},
 		{
 			Name: "IS_FREE_LOCK",
@@ -1451,7 +1603,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "json"},
+			ReturnType:        &ast.TypeName{Name: "json"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "JSON_ARRAY_APPEND",
@@ -1691,7 +1844,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "json"},
+			ReturnType:        &ast.TypeName{Name: "json"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "JSON_OVERLAPS",
@@ -2253,7 +2407,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MAX",
@@ -2263,7 +2418,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "int"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType:        &ast.TypeName{Name: "int"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MAX",
@@ -2273,7 +2429,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "double"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double"},
+			ReturnType:        &ast.TypeName{Name: "double"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MAX",
@@ -2283,7 +2440,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "string"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "string"},
+			ReturnType:        &ast.TypeName{Name: "string"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MAX",
@@ -2292,7 +2450,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MBRCONTAINS",
@@ -2443,7 +2602,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MIN",
@@ -2453,7 +2613,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "int"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType:        &ast.TypeName{Name: "int"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MIN",
@@ -2463,7 +2624,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "double"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double"},
+			ReturnType:        &ast.TypeName{Name: "double"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MIN",
@@ -2473,7 +2635,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "string"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "string"},
+			ReturnType:        &ast.TypeName{Name: "string"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MIN",
@@ -2482,7 +2645,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "MINUTE",
@@ -2607,7 +2771,7 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "int"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType: &ast.TypeName{Name: "bigint"},
 		},
 		{
 			Name: "NULLIF",
@@ -2619,7 +2783,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "OCT",
@@ -2810,7 +2975,7 @@ func defaultSchema(name string) *catalog.Schema {
 		{
 			Name:       "RANK",
 			Args:       []*catalog.Argument{},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType: &ast.TypeName{Name: "bigint"},
 		},
 		{
 			Name: "REGEXP_INSTR",
@@ -3003,7 +3168,7 @@ func defaultSchema(name string) *catalog.Schema {
 		{
 			Name:       "ROW_NUMBER",
 			Args:       []*catalog.Argument{},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType: &ast.TypeName{Name: "bigint"},
 		},
 		{
 			Name: "RPAD",
@@ -3166,7 +3331,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "STDDEV",
@@ -3175,7 +3341,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "STDDEV_POP",
@@ -3184,7 +3351,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "STDDEV_SAMP",
@@ -3193,7 +3361,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "STRCMP",
@@ -5305,6 +5474,51 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "date"},
 		},
+		{
+			Name: "SUBDATE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "date"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "date"},
+		},
+		{
+			Name: "SUBDATE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "datetime"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
+		{
+			Name: "SUBDATE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "timestamp"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "datetime"},
+		},
 		{
 			Name: "SUBSTR",
 			Args: []*catalog.Argument{
@@ -5394,7 +5608,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "SUM",
@@ -5404,7 +5619,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "int"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "int"},
+			ReturnType:        &ast.TypeName{Name: "int"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "SUM",
@@ -5414,7 +5630,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "double"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double"},
+			ReturnType:        &ast.TypeName{Name: "double"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "SUM",
@@ -5423,7 +5640,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name:       "SYSDATE",
@@ -5755,7 +5973,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "VAR_POP",
@@ -5764,7 +5983,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "VAR_SAMP",
@@ -5773,7 +5993,8 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:        &ast.TypeName{Name: "any"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name:       "VERSION",
@@ -1,7 +1,14 @@
 package dolphin
 
 import (
+	"fmt"
+	"strings"
+
 	pcast "github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+	"github.com/pingcap/parser/mysql"
+	driver "github.com/pingcap/parser/test_driver"
+	"github.com/pingcap/parser/types"
 
 	"github.com/xiazemin/sqlc/internal/sql/ast"
 )
@@ -52,6 +59,23 @@ func visit(root pcast.Node, f func(pcast.Node)) {
 	root.Accept(ns)
 }
 
+// usesUserVariables reports whether @name in a statement refers to a MySQL
+// user variable rather than a named parameter. That is the case when the
+// statement assigns to a variable, or when it uses ? placeholders, which can't
+// be mixed with named parameters.
+func usesUserVariables(root pcast.Node) bool {
+	found := collect(root, func(n pcast.Node) bool {
+		switch n := n.(type) {
+		case *pcast.VariableExpr:
+			return n.Value != nil
+		case *driver.ParamMarkerExpr:
+			return true
+		}
+		return false
+	})
+	return len(found) > 0
+}
+
 // Maybe not useful?
 func text(nodes []pcast.Node) []string {
 	str := make([]string, len(nodes))
@@ -96,3 +120,142 @@ func isNotNull(n *pcast.ColumnDef) bool {
 	}
 	return false
 }
+
+func isAutoIncrement(n *pcast.ColumnDef) bool {
+	for _, opt := range n.Options {
+		if opt.Tp == pcast.ColumnOptionAutoIncrement {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultExpr returns the DEFAULT expression of a column definition, or an
+// empty string if it has none.
+func defaultExpr(n *pcast.ColumnDef) string {
+	for _, opt := range n.Options {
+		if opt.Tp == pcast.ColumnOptionDefaultValue {
+			return restoreExpr(opt.Expr)
+		}
+	}
+	return ""
+}
+
+// generatedExpr returns the expression of a generated column, or an empty
+// string for any other column.
+func generatedExpr(n *pcast.ColumnDef) string {
+	for _, opt := range n.Options {
+		if opt.Tp == pcast.ColumnOptionGenerated {
+			return restoreExpr(opt.Expr)
+		}
+	}
+	return ""
+}
+
+// restoreExpr returns the SQL text of an expression.
+func restoreExpr(n pcast.ExprNode) string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	flags := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	if err := n.Restore(format.NewRestoreCtx(flags, &b)); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
+// columnType builds the type name of a column definition.
+func columnType(tp *types.FieldType) *ast.TypeName {
+	return &ast.TypeName{
+		Name:     types.TypeStr(tp.Tp),
+		Unsigned: mysql.HasUnsignedFlag(tp.Flag),
+	}
+}
+
+// columnVals returns the members of an ENUM or SET column, or nil for any
+// other type.
+func columnVals(tp *types.FieldType) *ast.List {
+	if tp.Tp != mysql.TypeEnum && tp.Tp != mysql.TypeSet {
+		return nil
+	}
+	vals := &ast.List{}
+	for _, elem := range tp.Elems {
+		vals.Items = append(vals.Items, &ast.String{Str: elem})
+	}
+	return vals
+}
+
+// indexColumns returns the column names of an index. Parts that index an
+// expression have no name and are skipped.
+func indexColumns(parts []*pcast.IndexPartSpecification) []string {
+	var names []string
+	for _, part := range parts {
+		if part.Column != nil {
+			names = append(names, part.Column.Name.O)
+		}
+	}
+	return names
+}
+
+// nameConstraints names the unnamed keys of a new table the way MySQL does.
+func nameConstraints(table string, cons []*ast.TableConstraint) {
+	used := map[string]bool{}
+	for _, con := range cons {
+		if con.Name != "" {
+			used[con.Name] = true
+		}
+	}
+	taken := func(name string) bool { return used[name] }
+	for _, con := range cons {
+		if con.Name == "" {
+			con.Name = keyName(table, con, taken)
+		}
+		if con.Name != "" {
+			used[con.Name] = true
+		}
+	}
+}
+
+// keyName names an unnamed key the way MySQL does: an index after its first
+// column, with a _2, _3, ... suffix if that name is taken, and a foreign key
+// <table>_ibfk_<n>.
+func keyName(table string, con *ast.TableConstraint, taken func(string) bool) string {
+	if con.Kind == ast.ConstraintForeignKey {
+		name := ""
+		for i := 1; name == "" || taken(name); i++ {
+			name = fmt.Sprintf("%s_ibfk_%d", table, i)
+		}
+		return name
+	}
+	if len(con.Columns) == 0 {
+		return ""
+	}
+	name := con.Columns[0]
+	for i := 2; taken(name); i++ {
+		name = fmt.Sprintf("%s_%d", con.Columns[0], i)
+	}
+	return name
+}
+
+// castType builds the type name of a TypeCast. Unlike column definitions,
+// casts are read through Names.
+func castType(name string) *ast.TypeName {
+	return &ast.TypeName{
+		Name: name,
+		Names: &ast.List{
+			Items: []ast.Node{&ast.String{Str: name}},
+		},
+	}
+}
+
+func joinType(tp pcast.JoinType) ast.JoinType {
+	switch tp {
+	case pcast.LeftJoin:
+		return ast.JoinTypeLeft
+	case pcast.RightJoin:
+		return ast.JoinTypeRight
+	default:
+		return ast.JoinTypeInner
+	}
+}
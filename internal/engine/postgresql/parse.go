@@ -147,6 +147,42 @@ func parseRelation(node nodes.Node) (*relation, error) {
 	}
 }
 
+// parseConstraint converts a PRIMARY KEY, UNIQUE or FOREIGN KEY constraint.
+// Column constraints pass the name of their column, which is the key when
+// the constraint lists none. Other kinds of constraint return nil.
+func parseConstraint(n nodes.Constraint, column *string) (*ast.TableConstraint, error) {
+	con := &ast.TableConstraint{}
+	if n.Conname != nil {
+		con.Name = *n.Conname
+	}
+	switch n.Contype {
+	case nodes.CONSTR_PRIMARY:
+		con.Kind = ast.ConstraintPrimaryKey
+		con.Columns = stringSlice(n.Keys)
+	case nodes.CONSTR_UNIQUE:
+		con.Kind = ast.ConstraintUnique
+		con.Columns = stringSlice(n.Keys)
+	case nodes.CONSTR_FOREIGN:
+		if n.Pktable == nil {
+			return nil, fmt.Errorf("foreign key constraint without a referenced table")
+		}
+		ref, err := parseTableName(*n.Pktable)
+		if err != nil {
+			return nil, err
+		}
+		con.Kind = ast.ConstraintForeignKey
+		con.Columns = stringSlice(n.FkAttrs)
+		con.RefTable = ref
+		con.RefColumns = stringSlice(n.PkAttrs)
+	default:
+		return nil, nil
+	}
+	if len(con.Columns) == 0 && column != nil {
+		con.Columns = []string{*column}
+	}
+	return con, nil
+}
+
 func parseColName(node nodes.Node) (*ast.ColumnRef, *ast.TableName, error) {
 	switch n := node.(type) {
 	case nodes.List:
@@ -201,7 +237,7 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 		if !ok {
 			return nil, fmt.Errorf("expected RawStmt; got %T", stmt)
 		}
-		n, err := translate(raw.Stmt)
+		n, err := translate(raw.Stmt, string(contents))
 		if err == errSkip {
 			continue
 		}
@@ -230,7 +266,9 @@ func (p *Parser) CommentSyntax() metadata.CommentSyntax {
 	}
 }
 
-func translate(node nodes.Node) (ast.Node, error) {
+// translate converts a statement parsed from src. The source is needed to
+// recover the text of column defaults.
+func translate(node nodes.Node, src string) (ast.Node, error) {
 	switch n := node.(type) {
 
 	case nodes.AlterEnumStmt:
@@ -290,10 +328,12 @@ func translate(node nodes.Node) (ast.Node, error) {
 					}
 					item.Subtype = ast.AT_AddColumn
 					item.Def = &ast.ColumnDef{
-						Colname:   *d.Colname,
-						TypeName:  tn,
-						IsNotNull: isNotNull(d),
-						IsArray:   isArray(d.TypeName),
+						Colname:         *d.Colname,
+						TypeName:        tn,
+						IsNotNull:       isNotNull(d),
+						IsArray:         isArray(d.TypeName),
+						Default:         columnDefault(src, d),
+						IsAutoIncrement: isAutoIncrement(d),
 					}
 
 				case nodes.AT_AlterColumnType:
@@ -324,6 +364,24 @@ func translate(node nodes.Node) (ast.Node, error) {
 				case nodes.AT_DropNotNull:
 					item.Subtype = ast.AT_DropNotNull
 
+				case nodes.AT_AddConstraint:
+					c, ok := cmd.Def.(nodes.Constraint)
+					if !ok {
+						continue
+					}
+					con, err := parseConstraint(c, nil)
+					if err != nil {
+						return nil, err
+					}
+					if con == nil {
+						continue
+					}
+					item.Subtype = ast.AT_AddConstraint
+					item.Constraint = con
+
+				case nodes.AT_DropConstraint:
+					item.Subtype = ast.AT_DropConstraint
+
 				case nodes.AT_SetNotNull:
 					item.Subtype = ast.AT_SetNotNull
 
@@ -405,11 +463,19 @@ func translate(node nodes.Node) (ast.Node, error) {
 		for _, elt := range n.TableElts.Items {
 			switch n := elt.(type) {
 			case nodes.Constraint:
-				if n.Contype == nodes.CONSTR_PRIMARY {
-					for _, item := range n.Keys.Items {
-						primaryKey[item.(nodes.String).Str] = true
+				con, err := parseConstraint(n, nil)
+				if err != nil {
+					return nil, err
+				}
+				if con == nil {
+					continue
+				}
+				if con.Kind == ast.ConstraintPrimaryKey {
+					for _, name := range con.Columns {
+						primaryKey[name] = true
 					}
 				}
+				create.Constraints = append(create.Constraints, con)
 			}
 		}
 		for _, elt := range n.TableElts.Items {
@@ -419,11 +485,24 @@ func translate(node nodes.Node) (ast.Node, error) {
 				if err != nil {
 					return nil, err
 				}
+				for _, c := range n.Constraints.Items {
+					if c, ok := c.(nodes.Constraint); ok {
+						con, err := parseConstraint(c, n.Colname)
+						if err != nil {
+							return nil, err
+						}
+						if con != nil {
+							create.Constraints = append(create.Constraints, con)
+						}
+					}
+				}
 				create.Cols = append(create.Cols, &ast.ColumnDef{
-					Colname:   *n.Colname,
-					TypeName:  tn,
-					IsNotNull: isNotNull(n) || primaryKey[*n.Colname],
-					IsArray:   isArray(n.TypeName),
+					Colname:         *n.Colname,
+					TypeName:        tn,
+					IsNotNull:       isNotNull(n) || primaryKey[*n.Colname],
+					IsArray:         isArray(n.TypeName),
+					Default:         columnDefault(src, n),
+					IsAutoIncrement: isAutoIncrement(n),
 				})
 			}
 		}
@@ -527,6 +606,19 @@ func translate(node nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
+		case nodes.OBJECT_INDEX:
+			drop := &ast.DropIndexStmt{
+				IfExists: n.MissingOk,
+			}
+			for _, obj := range n.Objects.Items {
+				name, err := parseTableName(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: INDEX: %w", err)
+				}
+				drop.Indexes = append(drop.Indexes, name)
+			}
+			return drop, nil
+
 		case nodes.OBJECT_SCHEMA:
 			drop := &ast.DropSchemaStmt{
 				MissingOk: n.MissingOk,
@@ -540,7 +632,7 @@ func translate(node nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.OBJECT_TABLE:
+		case nodes.OBJECT_TABLE, nodes.OBJECT_VIEW, nodes.OBJECT_MATVIEW:
 			drop := &ast.DropTableStmt{
 				IfExists: n.MissingOk,
 			}
@@ -476,7 +476,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyarray"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyarray"},
+			ReturnType:        &ast.TypeName{Name: "anyarray"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "array_agg",
@@ -485,7 +486,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anynonarray"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyarray"},
+			ReturnType:        &ast.TypeName{Name: "anyarray"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "array_append",
@@ -986,7 +988,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "avg",
@@ -995,7 +998,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "avg",
@@ -1004,7 +1008,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "avg",
@@ -1013,7 +1018,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "interval"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "interval"},
+			ReturnType:        &ast.TypeName{Name: "interval"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "avg",
@@ -1022,7 +1028,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "avg",
@@ -1031,7 +1038,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "avg",
@@ -1040,7 +1048,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "binary_upgrade_create_empty_extension",
@@ -1211,7 +1220,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_and",
@@ -1220,7 +1230,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "smallint"},
+			ReturnType:        &ast.TypeName{Name: "smallint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_and",
@@ -1229,7 +1240,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "integer"},
+			ReturnType:        &ast.TypeName{Name: "integer"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_and",
@@ -1238,7 +1250,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bit"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bit"},
+			ReturnType:        &ast.TypeName{Name: "bit"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_in",
@@ -1289,7 +1302,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "smallint"},
+			ReturnType:        &ast.TypeName{Name: "smallint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_or",
@@ -1298,7 +1312,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "integer"},
+			ReturnType:        &ast.TypeName{Name: "integer"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_or",
@@ -1307,7 +1322,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bit"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bit"},
+			ReturnType:        &ast.TypeName{Name: "bit"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_or",
@@ -1316,7 +1332,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bit_out",
@@ -1544,7 +1561,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "boolean"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "boolean"},
+			ReturnType:        &ast.TypeName{Name: "boolean"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "bool_or",
@@ -1553,7 +1571,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "boolean"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "boolean"},
+			ReturnType:        &ast.TypeName{Name: "boolean"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "booland_statefunc",
@@ -4084,7 +4103,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "cos",
@@ -4138,12 +4158,14 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.NeverNull,
 		},
 		{
-			Name:       "count",
-			Args:       []*catalog.Argument{},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			Name:              "count",
+			Args:              []*catalog.Argument{},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.NeverNull,
 		},
 		{
 			Name: "covar_pop",
@@ -4155,7 +4177,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "covar_samp",
@@ -4167,7 +4190,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "cstring_in",
@@ -5478,7 +5502,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "boolean"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "boolean"},
+			ReturnType:        &ast.TypeName{Name: "boolean"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "exp",
@@ -11606,7 +11631,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "json"},
+			ReturnType:        &ast.TypeName{Name: "json"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "json_array_element",
@@ -11705,7 +11731,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "json"},
+			ReturnType:        &ast.TypeName{Name: "json"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "json_object_field",
@@ -11870,7 +11897,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "jsonb"},
+			ReturnType:        &ast.TypeName{Name: "jsonb"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "jsonb_array_element",
@@ -12195,7 +12223,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "jsonb"},
+			ReturnType:        &ast.TypeName{Name: "jsonb"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "jsonb_object_field",
@@ -14063,7 +14092,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "timestamp with time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "timestamp with time zone"},
+			ReturnType:        &ast.TypeName{Name: "timestamp with time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14072,7 +14102,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "inet"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "inet"},
+			ReturnType:        &ast.TypeName{Name: "inet"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14081,7 +14112,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyenum"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyenum"},
+			ReturnType:        &ast.TypeName{Name: "anyenum"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14090,7 +14122,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "integer"},
+			ReturnType:        &ast.TypeName{Name: "integer"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14099,7 +14132,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "smallint"},
+			ReturnType:        &ast.TypeName{Name: "smallint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14108,7 +14142,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "oid"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "oid"},
+			ReturnType:        &ast.TypeName{Name: "oid"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14117,7 +14152,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "real"},
+			ReturnType:        &ast.TypeName{Name: "real"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14126,7 +14162,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14135,7 +14172,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "date"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "date"},
+			ReturnType:        &ast.TypeName{Name: "date"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14144,7 +14182,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "time without time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "time without time zone"},
+			ReturnType:        &ast.TypeName{Name: "time without time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14153,7 +14192,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "time with time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "time with time zone"},
+			ReturnType:        &ast.TypeName{Name: "time with time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14162,7 +14202,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "money"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "money"},
+			ReturnType:        &ast.TypeName{Name: "money"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14171,7 +14212,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "timestamp without time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "timestamp without time zone"},
+			ReturnType:        &ast.TypeName{Name: "timestamp without time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14180,7 +14222,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "interval"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "interval"},
+			ReturnType:        &ast.TypeName{Name: "interval"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14189,7 +14232,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "text"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "text"},
+			ReturnType:        &ast.TypeName{Name: "text"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14198,7 +14242,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14207,7 +14252,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyarray"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyarray"},
+			ReturnType:        &ast.TypeName{Name: "anyarray"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14216,7 +14262,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "character"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "character"},
+			ReturnType:        &ast.TypeName{Name: "character"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14225,7 +14272,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "tid"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "tid"},
+			ReturnType:        &ast.TypeName{Name: "tid"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "max",
@@ -14234,7 +14282,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "md5",
@@ -14261,7 +14310,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "timestamp with time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "timestamp with time zone"},
+			ReturnType:        &ast.TypeName{Name: "timestamp with time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14270,7 +14320,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "interval"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "interval"},
+			ReturnType:        &ast.TypeName{Name: "interval"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14279,7 +14330,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "character"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "character"},
+			ReturnType:        &ast.TypeName{Name: "character"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14288,7 +14340,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "smallint"},
+			ReturnType:        &ast.TypeName{Name: "smallint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14297,7 +14350,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14306,7 +14360,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "oid"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "oid"},
+			ReturnType:        &ast.TypeName{Name: "oid"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14315,7 +14370,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "text"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "text"},
+			ReturnType:        &ast.TypeName{Name: "text"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14324,7 +14380,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "time without time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "time without time zone"},
+			ReturnType:        &ast.TypeName{Name: "time without time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14333,7 +14390,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "time with time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "time with time zone"},
+			ReturnType:        &ast.TypeName{Name: "time with time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14342,7 +14400,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "inet"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "inet"},
+			ReturnType:        &ast.TypeName{Name: "inet"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14351,7 +14410,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "money"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "money"},
+			ReturnType:        &ast.TypeName{Name: "money"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14360,7 +14420,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14369,7 +14430,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "timestamp without time zone"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "timestamp without time zone"},
+			ReturnType:        &ast.TypeName{Name: "timestamp without time zone"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14378,7 +14440,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "real"},
+			ReturnType:        &ast.TypeName{Name: "real"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14387,7 +14450,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyarray"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyarray"},
+			ReturnType:        &ast.TypeName{Name: "anyarray"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14396,7 +14460,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyenum"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyenum"},
+			ReturnType:        &ast.TypeName{Name: "anyenum"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14405,7 +14470,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14414,7 +14480,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "integer"},
+			ReturnType:        &ast.TypeName{Name: "integer"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14423,7 +14490,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "tid"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "tid"},
+			ReturnType:        &ast.TypeName{Name: "tid"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "min",
@@ -14432,7 +14500,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "date"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "date"},
+			ReturnType:        &ast.TypeName{Name: "date"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "mod",
@@ -14489,7 +14558,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyelement"},
+			ReturnType:        &ast.TypeName{Name: "anyelement"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "money",
@@ -16548,7 +16618,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "interval"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "interval"},
+			ReturnType:        &ast.TypeName{Name: "interval"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "percentile_cont",
@@ -16560,7 +16631,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision[]"},
+			ReturnType:        &ast.TypeName{Name: "double precision[]"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "percentile_cont",
@@ -16572,7 +16644,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "percentile_cont",
@@ -16584,7 +16657,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "interval"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "interval[]"},
+			ReturnType:        &ast.TypeName{Name: "interval[]"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "percentile_disc",
@@ -16596,7 +16670,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyelement"},
+			ReturnType:        &ast.TypeName{Name: "anyelement"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "percentile_disc",
@@ -16608,7 +16683,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "anyarray"},
+			ReturnType:        &ast.TypeName{Name: "anyarray"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "pg_advisory_lock",
@@ -20983,7 +21059,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_avgy",
@@ -20995,7 +21072,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_count",
@@ -21007,7 +21085,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.NeverNull,
 		},
 		{
 			Name: "regr_intercept",
@@ -21019,7 +21098,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_r2",
@@ -21031,7 +21111,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_slope",
@@ -21043,7 +21124,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_sxx",
@@ -21055,7 +21137,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_sxy",
@@ -21067,7 +21150,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regr_syy",
@@ -21079,7 +21163,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "regrolein",
@@ -21733,7 +21818,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev",
@@ -21742,7 +21828,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev",
@@ -21751,7 +21838,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev",
@@ -21760,7 +21848,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev",
@@ -21769,7 +21858,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev",
@@ -21778,7 +21868,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_pop",
@@ -21787,7 +21878,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_pop",
@@ -21796,7 +21888,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_pop",
@@ -21805,7 +21898,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_pop",
@@ -21814,7 +21908,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_pop",
@@ -21823,7 +21918,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_pop",
@@ -21832,7 +21928,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_samp",
@@ -21841,7 +21938,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_samp",
@@ -21850,7 +21948,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_samp",
@@ -21859,7 +21958,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_samp",
@@ -21868,7 +21968,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_samp",
@@ -21877,7 +21978,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "stddev_samp",
@@ -21886,7 +21988,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "string_agg",
@@ -21898,7 +22001,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bytea"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bytea"},
+			ReturnType:        &ast.TypeName{Name: "bytea"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "string_agg",
@@ -21910,7 +22014,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "text"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "text"},
+			ReturnType:        &ast.TypeName{Name: "text"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "string_to_array",
@@ -22129,7 +22234,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22138,7 +22244,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22147,7 +22254,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "interval"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "interval"},
+			ReturnType:        &ast.TypeName{Name: "interval"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22156,7 +22264,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "money"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "money"},
+			ReturnType:        &ast.TypeName{Name: "money"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22165,7 +22274,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22174,7 +22284,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "real"},
+			ReturnType:        &ast.TypeName{Name: "real"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22183,7 +22294,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "bigint"},
+			ReturnType:        &ast.TypeName{Name: "bigint"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "sum",
@@ -22192,7 +22304,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name:       "suppress_redundant_updates_trigger",
@@ -25915,7 +26028,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_pop",
@@ -25924,7 +26038,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_pop",
@@ -25933,7 +26048,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_pop",
@@ -25942,7 +26058,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_pop",
@@ -25951,7 +26068,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_pop",
@@ -25960,7 +26078,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_samp",
@@ -25969,7 +26088,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_samp",
@@ -25978,7 +26098,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_samp",
@@ -25987,7 +26108,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_samp",
@@ -25996,7 +26118,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_samp",
@@ -26005,7 +26128,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "var_samp",
@@ -26014,7 +26138,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "varbit",
@@ -26248,7 +26373,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "numeric"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "variance",
@@ -26257,7 +26383,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "bigint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "variance",
@@ -26266,7 +26393,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "integer"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "variance",
@@ -26275,7 +26403,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "smallint"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "numeric"},
+			ReturnType:        &ast.TypeName{Name: "numeric"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "variance",
@@ -26284,7 +26413,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "real"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "variance",
@@ -26293,7 +26423,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "double precision"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "double precision"},
+			ReturnType:        &ast.TypeName{Name: "double precision"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name:       "version",
@@ -26550,7 +26681,8 @@ func genPGCatalog() *catalog.Schema {
 					Type: &ast.TypeName{Name: "xml"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "xml"},
+			ReturnType:        &ast.TypeName{Name: "xml"},
+			ReturnNullability: catalog.AlwaysNullable,
 		},
 		{
 			Name: "xmlcomment",
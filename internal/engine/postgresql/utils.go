@@ -3,6 +3,8 @@
 package postgresql
 
 import (
+	"strings"
+
 	nodes "github.com/lfittl/pg_query_go/nodes"
 )
 
@@ -26,11 +28,87 @@ func isNotNull(n nodes.ColumnDef) bool {
 			if n.Contype == nodes.CONSTR_PRIMARY {
 				return true
 			}
+			// Identity columns are implicitly NOT NULL
+			if n.Contype == nodes.CONSTR_IDENTITY {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isAutoIncrement reports whether a column is a serial or identity column.
+func isAutoIncrement(n nodes.ColumnDef) bool {
+	if n.TypeName != nil && len(n.TypeName.Names.Items) > 0 {
+		last := n.TypeName.Names.Items[len(n.TypeName.Names.Items)-1]
+		if name, ok := last.(nodes.String); ok {
+			switch name.Str {
+			case "serial", "serial2", "serial4", "serial8", "smallserial", "bigserial":
+				return true
+			}
+		}
+	}
+	for _, c := range n.Constraints.Items {
+		if c, ok := c.(nodes.Constraint); ok && c.Contype == nodes.CONSTR_IDENTITY {
+			return true
 		}
 	}
 	return false
 }
 
+// columnDefault returns the DEFAULT expression of a column as it is written
+// in src, the text the column was parsed from. The parser predates generated
+// columns, so those cannot occur.
+func columnDefault(src string, n nodes.ColumnDef) string {
+	for i, c := range n.Constraints.Items {
+		con, ok := c.(nodes.Constraint)
+		if !ok || con.Contype != nodes.CONSTR_DEFAULT || con.Location < 0 || con.Location >= len(src) {
+			continue
+		}
+		// The expression ends where the next constraint of the column starts.
+		end := len(src)
+		if i+1 < len(n.Constraints.Items) {
+			if next, ok := n.Constraints.Items[i+1].(nodes.Constraint); ok && next.Location > con.Location {
+				end = next.Location
+			}
+		}
+		text := src[con.Location:end]
+		if len(text) >= len("DEFAULT") && strings.EqualFold(text[:len("DEFAULT")], "DEFAULT") {
+			text = text[len("DEFAULT"):]
+		}
+		return exprText(text)
+	}
+	return ""
+}
+
+// exprText returns the expression at the start of s, which runs up to the
+// first comma, semicolon or unbalanced closing parenthesis outside of quotes.
+func exprText(s string) string {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			if depth == 0 {
+				return strings.TrimSpace(s[:i])
+			}
+			depth--
+		case (ch == ',' || ch == ';') && depth == 0:
+			return strings.TrimSpace(s[:i])
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
 func IsNamedParamFunc(node nodes.Node) bool {
 	fun, ok := node.(nodes.FuncCall)
 	return ok && join(fun.Funcname, ".") == "sqlc.arg"
@@ -16,8 +16,11 @@ const (
 	CmdExec       = ":exec"
 	CmdExecResult = ":execresult"
 	CmdExecRows   = ":execrows"
+	CmdIter       = ":iter"
 	CmdMany       = ":many"
+	CmdNested     = ":nested"
 	CmdOne        = ":one"
+	CmdPaginate   = ":paginate"
 )
 
 // A query name must be a valid Go identifier
@@ -72,7 +75,7 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 			part = part[:len(part)-1] // removes the trailing "*/" element
 		}
 		if len(part) == 2 {
-			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execresult']: %s", line)
+			return "", "", fmt.Errorf("missing query type [':one', ':many', ':iter', ':paginate', ':nested', ':exec', ':execrows', ':execresult']: %s", line)
 		}
 		if len(part) != 4 {
 			return "", "", fmt.Errorf("invalid query comment: %s", line)
@@ -80,7 +83,7 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows:
+		case CmdOne, CmdMany, CmdIter, CmdPaginate, CmdNested, CmdExec, CmdExecResult, CmdExecRows:
 		default:
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
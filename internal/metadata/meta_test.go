@@ -16,3 +16,15 @@ func TestParseMetadata(t *testing.T) {
 		}
 	}
 }
+
+func TestParseMetadataCmd(t *testing.T) {
+	for _, want := range []string{CmdIter, CmdPaginate, CmdNested} {
+		name, cmd, err := Parse(`-- name: ListFoos `+want, CommentSyntax{Dash: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name != "ListFoos" || cmd != want {
+			t.Errorf("unexpected metadata: %q %q", name, cmd)
+		}
+	}
+}
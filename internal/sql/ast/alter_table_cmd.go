@@ -6,6 +6,8 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_AddConstraint
+	AT_DropConstraint
 )
 
 type AlterTableType int
@@ -22,18 +24,27 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_AddConstraint:
+		return "AddConstraint"
+	case AT_DropConstraint:
+		return "DropConstraint"
 	default:
 		return "Unknown"
 	}
 }
 
 type AlterTableCmd struct {
-	Subtype   AlterTableType
-	Name      *string
-	Def       *ColumnDef
-	Newowner  *RoleSpec
-	Behavior  DropBehavior
-	MissingOk bool
+	Subtype    AlterTableType
+	Name       *string
+	Def        *ColumnDef
+	Constraint *TableConstraint
+	Newowner   *RoleSpec
+	Behavior   DropBehavior
+	MissingOk  bool
+
+	// Modify is set on the drop half of a MySQL MODIFY COLUMN, which
+	// redefines the column but keeps the keys and indexes using it.
+	Modify bool
 }
 
 func (n *AlterTableCmd) Pos() int {
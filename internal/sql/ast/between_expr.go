@@ -0,0 +1,17 @@
+package ast
+
+type BetweenExpr struct {
+	// Expr is the value expression to be compared.
+	Expr Node
+	// Left is the lower bound of the range.
+	Left Node
+	// Right is the upper bound of the range.
+	Right Node
+	// Not is true, the expression is "not between".
+	Not      bool
+	Location int
+}
+
+func (n *BetweenExpr) Pos() int {
+	return n.Location
+}
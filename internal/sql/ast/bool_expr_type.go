@@ -2,6 +2,12 @@ package ast
 
 type BoolExprType uint
 
+const (
+	BoolExprTypeAnd BoolExprType = iota
+	BoolExprTypeOr
+	BoolExprTypeNot
+)
+
 func (n *BoolExprType) Pos() int {
 	return 0
 }
@@ -8,6 +8,12 @@ type ColumnDef struct {
 	Vals      *List
 	Length    *int
 
+	// Default and Generated hold the DEFAULT and GENERATED ALWAYS AS
+	// expressions as written in the schema.
+	Default         string
+	Generated       string
+	IsAutoIncrement bool
+
 	// From pg.ColumnDef
 	Inhcount      int
 	IsLocal       bool
@@ -6,6 +6,12 @@ type DeleteStmt struct {
 	WhereClause   Node
 	ReturningList *List
 	WithClause    *WithClause
+	SortClause    *List
+	LimitCount    Node
+
+	// Targets lists the tables a MySQL multi-table DELETE removes rows
+	// from, by name or alias. It is not walked.
+	Targets *List
 }
 
 func (n *DeleteStmt) Pos() int {
@@ -0,0 +1,13 @@
+package ast
+
+// DropIndexStmt drops indexes by name. MySQL names the table of the index;
+// PostgreSQL index names are unique within their schema.
+type DropIndexStmt struct {
+	IfExists bool
+	Table    *TableName
+	Indexes  []*TableName
+}
+
+func (n *DropIndexStmt) Pos() int {
+	return 0
+}
@@ -0,0 +1,20 @@
+package ast
+
+// Bits of WindowDef.FrameOptions. The values match PostgreSQL, where each END
+// option is the matching START option shifted left by one.
+const (
+	FrameOptionNonDefault = 1 << iota
+	FrameOptionRange
+	FrameOptionRows
+	FrameOptionBetween
+	FrameOptionStartUnboundedPreceding
+	FrameOptionEndUnboundedPreceding
+	FrameOptionStartUnboundedFollowing
+	FrameOptionEndUnboundedFollowing
+	FrameOptionStartCurrentRow
+	FrameOptionEndCurrentRow
+	FrameOptionStartValuePreceding
+	FrameOptionEndValuePreceding
+	FrameOptionStartValueFollowing
+	FrameOptionEndValueFollowing
+)
@@ -1,7 +1,16 @@
 package ast
 
+// JoinType is the kind of a join. The values match those of the PostgreSQL
+// parser.
 type JoinType uint
 
+const (
+	JoinTypeInner JoinType = iota
+	JoinTypeLeft
+	JoinTypeFull
+	JoinTypeRight
+)
+
 func (n *JoinType) Pos() int {
 	return 0
 }
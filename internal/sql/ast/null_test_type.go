@@ -2,6 +2,11 @@ package ast
 
 type NullTestType uint
 
+const (
+	NullTestTypeIsNull NullTestType = iota
+	NullTestTypeIsNotNull
+)
+
 func (n *NullTestType) Pos() int {
 	return 0
 }
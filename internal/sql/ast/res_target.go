@@ -5,6 +5,10 @@ type ResTarget struct {
 	Indirection *List
 	Val         Node
 	Location    int
+
+	// TableName is the table or alias qualifying a SET target in a MySQL
+	// multi-table UPDATE, as in UPDATE a JOIN b ON ... SET b.x = ?
+	TableName string
 }
 
 func (n *ResTarget) Pos() int {
@@ -2,6 +2,13 @@ package ast
 
 type SetOperation uint
 
+const (
+	None SetOperation = iota
+	Union
+	Intersect
+	Except
+)
+
 func (n *SetOperation) Pos() int {
 	return 0
 }
@@ -2,6 +2,13 @@ package ast
 
 type SortByDir uint
 
+const (
+	SortByDirDefault SortByDir = iota
+	SortByDirAsc
+	SortByDirDesc
+	SortByDirUsing
+)
+
 func (n *SortByDir) Pos() int {
 	return 0
 }
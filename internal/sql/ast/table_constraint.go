@@ -0,0 +1,26 @@
+package ast
+
+// ConstraintKind is the kind of a TableConstraint.
+type ConstraintKind int
+
+const (
+	ConstraintPrimaryKey ConstraintKind = iota
+	ConstraintUnique
+	ConstraintForeignKey
+	ConstraintIndex
+)
+
+// TableConstraint is a PRIMARY KEY, UNIQUE or FOREIGN KEY constraint, or a
+// plain index, declared with a table. RefTable and RefColumns are only set
+// for foreign keys.
+type TableConstraint struct {
+	Kind       ConstraintKind
+	Name       string
+	Columns    []string
+	RefTable   *TableName
+	RefColumns []string
+}
+
+func (n *TableConstraint) Pos() int {
+	return 0
+}
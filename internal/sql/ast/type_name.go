@@ -5,6 +5,9 @@ type TypeName struct {
 	Schema  string
 	Name    string
 
+	// Unsigned is set for MySQL integer types declared UNSIGNED.
+	Unsigned bool
+
 	// From pg.TypeName
 	Names       *List
 	TypeOid     Oid
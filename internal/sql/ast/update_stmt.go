@@ -7,6 +7,8 @@ type UpdateStmt struct {
 	FromClause    *List
 	ReturningList *List
 	WithClause    *WithClause
+	SortClause    *List
+	LimitCount    Node
 }
 
 func (n *UpdateStmt) Pos() int {
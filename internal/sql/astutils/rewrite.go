@@ -171,6 +171,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -391,6 +394,11 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Refexpr", nil, n.Refexpr)
 		a.apply(n, "Refassgnexpr", nil, n.Refassgnexpr)
 
+	case *ast.BetweenExpr:
+		a.apply(n, "Expr", nil, n.Expr)
+		a.apply(n, "Left", nil, n.Left)
+		a.apply(n, "Right", nil, n.Right)
+
 	case *ast.BitString:
 		// pass
 
@@ -667,6 +675,8 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "WhereClause", nil, n.WhereClause)
 		a.apply(n, "ReturningList", nil, n.ReturningList)
 		a.apply(n, "WithClause", nil, n.WithClause)
+		a.apply(n, "SortClause", nil, n.SortClause)
+		a.apply(n, "LimitCount", nil, n.LimitCount)
 
 	case *ast.DiscardStmt:
 		// pass
@@ -1141,6 +1151,8 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "FromClause", nil, n.FromClause)
 		a.apply(n, "ReturningList", nil, n.ReturningList)
 		a.apply(n, "WithClause", nil, n.WithClause)
+		a.apply(n, "SortClause", nil, n.SortClause)
+		a.apply(n, "LimitCount", nil, n.LimitCount)
 
 	case *ast.VacuumStmt:
 		a.apply(n, "Relation", nil, n.Relation)
@@ -86,6 +86,9 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -474,6 +477,17 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Refassgnexpr)
 		}
 
+	case *ast.BetweenExpr:
+		if n.Expr != nil {
+			Walk(f, n.Expr)
+		}
+		if n.Left != nil {
+			Walk(f, n.Left)
+		}
+		if n.Right != nil {
+			Walk(f, n.Right)
+		}
+
 	case *ast.BitString:
 		// pass
 
@@ -1041,6 +1055,12 @@ func Walk(f Visitor, node ast.Node) {
 		if n.WithClause != nil {
 			Walk(f, n.WithClause)
 		}
+		if n.SortClause != nil {
+			Walk(f, n.SortClause)
+		}
+		if n.LimitCount != nil {
+			Walk(f, n.LimitCount)
+		}
 
 	case *ast.DiscardStmt:
 		// pass
@@ -2012,6 +2032,12 @@ func Walk(f Visitor, node ast.Node) {
 		if n.WithClause != nil {
 			Walk(f, n.WithClause)
 		}
+		if n.SortClause != nil {
+			Walk(f, n.SortClause)
+		}
+		if n.LimitCount != nil {
+			Walk(f, n.LimitCount)
+		}
 
 	case *ast.VacuumStmt:
 		if n.Relation != nil {
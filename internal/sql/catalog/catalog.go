@@ -25,6 +25,10 @@ type Catalog struct {
 	SearchPath    []string
 	LoadExtension func(string) *Schema
 
+	// KeyName names an unnamed key or index of a table, given whether a
+	// name is taken. Keys are named the way PostgreSQL does if it is nil.
+	KeyName func(table string, con *ast.TableConstraint, taken func(string) bool) string
+
 	// TODO: un-export
 	Extensions map[string]struct{}
 }
@@ -175,25 +179,46 @@ func (s *Schema) getType(rel *ast.TypeName) (Type, int, error) {
 			if typ.Name == rel.Name {
 				return s.Types[i], i, nil
 			}
+		case *Set:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
 		}
 	}
 	return nil, -1, sqlerr.TypeNotFound(rel.Name)
 }
 
 type Table struct {
-	Rel     *ast.TableName
-	Columns []*Column
-	Comment string
+	Rel         *ast.TableName
+	Columns     []*Column
+	Comment     string
+	Indexes     []*Index
+	ForeignKeys []*ForeignKey
+
+	// PrimaryKey and UniqueKeys hold the columns of the unique indexes.
+	PrimaryKey []string
+	UniqueKeys [][]string
+
+	// IsView is set for views, the only relations CREATE OR REPLACE VIEW
+	// may replace.
+	IsView bool
 }
 
 // TODO: Should this just be ast Nodes?
 type Column struct {
-	Name      string
-	Type      ast.TypeName
-	IsNotNull bool
-	IsArray   bool
-	Comment   string
-	Length    *int
+	Name       string
+	Type       ast.TypeName
+	IsNotNull  bool
+	IsArray    bool
+	IsUnsigned bool
+	Comment    string
+	Length     *int
+
+	// Default is the DEFAULT expression of the column and Generated the
+	// expression of a generated column; both are empty when absent.
+	Default         string
+	Generated       string
+	IsAutoIncrement bool
 }
 
 type Type interface {
@@ -216,6 +241,22 @@ func (e *Enum) SetComment(c string) {
 func (e *Enum) isType() {
 }
 
+// Set holds the members of a MySQL SET column; a value is any combination of
+// them.
+type Set struct {
+	Name      string
+	Vals      []string
+	IsNotNull bool
+	Comment   string
+}
+
+func (s *Set) SetComment(c string) {
+	s.Comment = c
+}
+
+func (s *Set) isType() {
+}
+
 type CompositeType struct {
 	Name    string
 	Comment string
@@ -229,11 +270,45 @@ func (ct *CompositeType) SetComment(c string) {
 }
 
 type Function struct {
-	Name       string
-	Args       []*Argument
-	ReturnType *ast.TypeName
-	Comment    string
-	Desc       string
+	Name              string
+	Args              []*Argument
+	ReturnType        *ast.TypeName
+	ReturnNullability Nullability
+	Comment           string
+	Desc              string
+}
+
+// Nullability describes when a function returns NULL.
+type Nullability int
+
+const (
+	// NullIfArgNull functions return NULL when given a nullable column, and a
+	// value when given only NOT NULL columns, constants and parameters. This
+	// is the default.
+	NullIfArgNull Nullability = iota
+	// NeverNull functions never return NULL, like COUNT.
+	NeverNull
+	// AlwaysNullable functions may return NULL whatever their arguments, like
+	// SUM over no rows.
+	AlwaysNullable
+	// NullIfAllArgsNull functions return NULL only when every argument is
+	// NULL, like IFNULL.
+	NullIfAllArgsNull
+)
+
+func (n Nullability) String() string {
+	switch n {
+	case NullIfArgNull:
+		return "NullIfArgNull"
+	case NeverNull:
+		return "NeverNull"
+	case AlwaysNullable:
+		return "AlwaysNullable"
+	case NullIfAllArgsNull:
+		return "NullIfAllArgsNull"
+	default:
+		return "Unknown"
+	}
 }
 
 func (f *Function) InArgs() []*Argument {
@@ -268,14 +343,16 @@ func New(def string) *Catalog {
 
 func (c *Catalog) Build(stmts []ast.Statement) error {
 	for i := range stmts {
-		if err := c.Update(stmts[i]); err != nil {
+		if err := c.Update(stmts[i], nil); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *Catalog) Update(stmt ast.Statement) error {
+// Update applies a schema statement to the catalog. colGen types the query of
+// a CREATE VIEW; it may be nil when the statements hold no views.
+func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	if stmt.Raw == nil {
 		return nil
 	}
@@ -320,12 +397,18 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.CreateSchemaStmt:
 		err = c.createSchema(n)
 
+	case *ast.CreateTableAsStmt:
+		err = c.createTableAs(n, colGen)
+
 	case *ast.CreateTableStmt:
 		err = c.createTable(n)
 
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropIndexStmt:
+		err = c.dropIndex(n)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
@@ -335,12 +418,18 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.DropTypeStmt:
 		err = c.dropType(n)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n)
+
 	case *ast.RenameColumnStmt:
 		err = c.renameColumn(n)
 
 	case *ast.RenameTableStmt:
 		err = c.renameTable(n)
 
+	case *ast.ViewStmt:
+		err = c.createView(n, colGen)
+
 	}
 	return err
 }
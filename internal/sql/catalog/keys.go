@@ -0,0 +1,287 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
+)
+
+// Index is an index of a table. Primary keys and UNIQUE constraints are
+// backed by a unique index named after the constraint, as they are in the
+// database.
+type Index struct {
+	Name      string
+	Columns   []string
+	IsUnique  bool
+	IsPrimary bool
+}
+
+// ForeignKey is a FOREIGN KEY constraint of a table.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefTable   *ast.TableName
+	RefColumns []string
+}
+
+// addConstraint records a key or index on the table. A foreign key without
+// referenced columns refers to the primary key of its table.
+func (c *Catalog) addConstraint(tbl *Table, con *ast.TableConstraint) error {
+	for _, name := range con.Columns {
+		if tbl.column(name) == nil {
+			return sqlerr.ColumnNotFound(tbl.Rel.Name, name)
+		}
+	}
+	name := con.Name
+	if name == "" && c.KeyName != nil {
+		name = c.KeyName(tbl.Rel.Name, con, tbl.hasKey)
+	}
+	if name == "" {
+		name = tbl.keyName(con)
+	} else if con.Name != "" && tbl.hasKey(name) {
+		return sqlerr.RelationExists(name)
+	}
+	columns := append([]string(nil), con.Columns...)
+
+	switch con.Kind {
+	case ast.ConstraintPrimaryKey:
+		for _, idx := range tbl.Indexes {
+			if idx.IsPrimary {
+				return fmt.Errorf("multiple primary keys for table %q are not allowed", tbl.Rel.Name)
+			}
+		}
+		for _, col := range columns {
+			tbl.column(col).IsNotNull = true
+		}
+		tbl.Indexes = append(tbl.Indexes, &Index{Name: name, Columns: columns, IsUnique: true, IsPrimary: true})
+
+	case ast.ConstraintUnique, ast.ConstraintIndex:
+		tbl.Indexes = append(tbl.Indexes, &Index{Name: name, Columns: columns, IsUnique: con.Kind == ast.ConstraintUnique})
+
+	case ast.ConstraintForeignKey:
+		ref := *con.RefTable
+		refColumns := append([]string(nil), con.RefColumns...)
+		if len(refColumns) == 0 {
+			refTable := tbl
+			if !sameRel(&ref, tbl.Rel) {
+				_, t, err := c.getTable(&ref)
+				if err != nil {
+					return err
+				}
+				refTable = t
+			}
+			refColumns = append(refColumns, refTable.PrimaryKey...)
+		}
+		tbl.ForeignKeys = append(tbl.ForeignKeys, &ForeignKey{
+			Name:       name,
+			Columns:    columns,
+			RefTable:   &ref,
+			RefColumns: refColumns,
+		})
+	}
+	tbl.syncKeys()
+	return nil
+}
+
+// dropConstraint removes the index or foreign key with the given name and
+// reports whether there was one.
+func (t *Table) dropConstraint(name string) bool {
+	for i, idx := range t.Indexes {
+		if idx.Name == name {
+			t.Indexes = append(t.Indexes[:i], t.Indexes[i+1:]...)
+			t.syncKeys()
+			return true
+		}
+	}
+	for i, fk := range t.ForeignKeys {
+		if fk.Name == name {
+			t.ForeignKeys = append(t.ForeignKeys[:i], t.ForeignKeys[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) column(name string) *Column {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func (t *Table) hasKey(name string) bool {
+	for _, idx := range t.Indexes {
+		if idx.Name == name {
+			return true
+		}
+	}
+	for _, fk := range t.ForeignKeys {
+		if fk.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sameRel reports whether two names refer to the same table; a name without
+// a schema matches the table in any schema.
+func sameRel(a, b *ast.TableName) bool {
+	return a.Name == b.Name && (a.Schema == b.Schema || a.Schema == "" || b.Schema == "")
+}
+
+// keyName returns the name PostgreSQL gives an unnamed constraint or index.
+func (t *Table) keyName(con *ast.TableConstraint) string {
+	var suffix string
+	switch con.Kind {
+	case ast.ConstraintPrimaryKey:
+		return t.Rel.Name + "_pkey"
+	case ast.ConstraintUnique:
+		suffix = "key"
+	case ast.ConstraintForeignKey:
+		suffix = "fkey"
+	default:
+		suffix = "idx"
+	}
+	base := t.Rel.Name
+	if len(con.Columns) > 0 {
+		base += "_" + strings.Join(con.Columns, "_")
+	}
+	name := base + "_" + suffix
+	for i := 1; t.hasKey(name); i++ {
+		name = fmt.Sprintf("%s%d_%s", base, i, suffix)
+	}
+	return name
+}
+
+// syncKeys sets PrimaryKey and UniqueKeys from the unique indexes.
+func (t *Table) syncKeys() {
+	t.PrimaryKey = nil
+	t.UniqueKeys = nil
+	for _, idx := range t.Indexes {
+		switch {
+		case idx.IsPrimary:
+			t.PrimaryKey = append([]string(nil), idx.Columns...)
+		case idx.IsUnique:
+			t.UniqueKeys = append(t.UniqueKeys, append([]string(nil), idx.Columns...))
+		}
+	}
+}
+
+// dropKeys removes the keys that contain the column, since dropping a column
+// also drops the constraints built on it.
+func (t *Table) dropKeys(column string) {
+	contains := func(key []string) bool {
+		for _, name := range key {
+			if name == column {
+				return true
+			}
+		}
+		return false
+	}
+	var indexes []*Index
+	for _, idx := range t.Indexes {
+		if !contains(idx.Columns) {
+			indexes = append(indexes, idx)
+		}
+	}
+	t.Indexes = indexes
+	var fks []*ForeignKey
+	for _, fk := range t.ForeignKeys {
+		if !contains(fk.Columns) {
+			fks = append(fks, fk)
+		}
+	}
+	t.ForeignKeys = fks
+	t.syncKeys()
+}
+
+func (t *Table) renameKeys(from, to string) {
+	rename := func(key []string) {
+		for i := range key {
+			if key[i] == from {
+				key[i] = to
+			}
+		}
+	}
+	for _, idx := range t.Indexes {
+		rename(idx.Columns)
+	}
+	for _, fk := range t.ForeignKeys {
+		rename(fk.Columns)
+		if sameRel(fk.RefTable, t.Rel) {
+			rename(fk.RefColumns)
+		}
+	}
+	t.syncKeys()
+}
+
+func (c *Catalog) createIndex(stmt *ast.IndexStmt) error {
+	tbl := &ast.TableName{}
+	if stmt.Relation.Schemaname != nil {
+		tbl.Schema = *stmt.Relation.Schemaname
+	}
+	if stmt.Relation.Relname != nil {
+		tbl.Name = *stmt.Relation.Relname
+	}
+	_, table, err := c.getTable(tbl)
+	if err != nil {
+		return err
+	}
+	con := &ast.TableConstraint{Kind: ast.ConstraintIndex}
+	if stmt.Unique {
+		con.Kind = ast.ConstraintUnique
+	}
+	if stmt.Idxname != nil {
+		con.Name = *stmt.Idxname
+		if stmt.IfNotExists && table.hasKey(con.Name) {
+			return nil
+		}
+	}
+	if stmt.IndexParams != nil {
+		for _, item := range stmt.IndexParams.Items {
+			// Expression indexes have no column name and are recorded by name only.
+			if elem, ok := item.(*ast.IndexElem); ok && elem.Name != nil {
+				con.Columns = append(con.Columns, *elem.Name)
+			}
+		}
+	}
+	return c.addConstraint(table, con)
+}
+
+func (c *Catalog) dropIndex(stmt *ast.DropIndexStmt) error {
+	for _, name := range stmt.Indexes {
+		var tables []*Table
+		if stmt.Table != nil {
+			_, table, err := c.getTable(stmt.Table)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, table)
+		} else {
+			ns := name.Schema
+			if ns == "" {
+				ns = c.DefaultSchema
+			}
+			schema, err := c.getSchema(ns)
+			if err != nil {
+				return err
+			}
+			tables = schema.Tables
+		}
+		var dropped bool
+		for _, table := range tables {
+			if table.dropConstraint(name.Name) {
+				dropped = true
+				break
+			}
+		}
+		if !dropped && !stmt.IfExists {
+			return sqlerr.RelationNotFound(name.Name)
+		}
+	}
+	return nil
+}
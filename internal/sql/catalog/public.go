@@ -51,6 +51,39 @@ func (c *Catalog) getaggColumn(tab *Table, inArgs *ast.List) *Column {
 	return nil
 }
 
+// typeAliases maps the SQL names of PostgreSQL types, used by the function
+// signatures of pg_catalog, to the internal names used for columns.
+var typeAliases = map[string]string{
+	"bigint":                      "int8",
+	"boolean":                     "bool",
+	"character":                   "bpchar",
+	"character varying":           "varchar",
+	"decimal":                     "numeric",
+	"double precision":            "float8",
+	"int":                         "int4",
+	"integer":                     "int4",
+	"real":                        "float4",
+	"smallint":                    "int2",
+	"time with time zone":         "timetz",
+	"time without time zone":      "time",
+	"timestamp with time zone":    "timestamptz",
+	"timestamp without time zone": "timestamp",
+}
+
+// sameTypeName reports whether two type names refer to the same type. The
+// pg_catalog schema of a column type is ignored.
+func sameTypeName(a, b string) bool {
+	return canonicalTypeName(a) == canonicalTypeName(b)
+}
+
+func canonicalTypeName(name string) string {
+	name = strings.TrimPrefix(strings.ToLower(name), "pg_catalog.")
+	if alias, ok := typeAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
 func (c *Catalog) paramMatch(arg []*Argument, inArgs *ast.List, tables []*Table) bool {
 	if inArgs == nil && arg == nil {
 		return true
@@ -73,7 +106,8 @@ func (c *Catalog) paramMatch(arg []*Argument, inArgs *ast.List, tables []*Table)
 			continue
 		}
 
-		if a.Type.Name == col.Type.Name {
+		// An argument of type any accepts every column.
+		if sameTypeName(a.Type.Name, col.Type.Name) || a.Type.Name == "any" {
 			return true
 		}
 	}
@@ -134,7 +168,7 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall, tables []*Table) (*Functio
 	var col *Column
 	if len(tables) > 0 {
 		col = c.getaggColumn(tables[0], call.Args)
-		if strings.ToLower(call.Func.Name) == "ifnull" {
+		if col != nil && strings.ToLower(call.Func.Name) == "ifnull" {
 			col.IsNotNull = true
 		}
 		util.Xiazeminlog("getaggColumn", col, false)
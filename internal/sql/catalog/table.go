@@ -25,6 +25,10 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 				implemented = true
 			case ast.AT_SetNotNull:
 				implemented = true
+			case ast.AT_AddConstraint:
+				implemented = true
+			case ast.AT_DropConstraint:
+				implemented = true
 			}
 		}
 	}
@@ -70,19 +74,37 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 						return sqlerr.ColumnExists(table.Rel.Name, c.Name)
 					}
 				}
-				table.Columns = append(table.Columns, &Column{
-					Name:      cmd.Def.Colname,
-					Type:      *cmd.Def.TypeName,
-					IsNotNull: cmd.Def.IsNotNull,
-					IsArray:   cmd.Def.IsArray,
-					Length:    cmd.Def.Length,
-				})
+				col := &Column{
+					Name:       cmd.Def.Colname,
+					Type:       *cmd.Def.TypeName,
+					IsNotNull:  cmd.Def.IsNotNull,
+					IsArray:    cmd.Def.IsArray,
+					IsUnsigned: cmd.Def.TypeName.Unsigned,
+					Length:     cmd.Def.Length,
+
+					Default:         cmd.Def.Default,
+					Generated:       cmd.Def.Generated,
+					IsAutoIncrement: cmd.Def.IsAutoIncrement,
+				}
+				if cmd.Def.Vals != nil {
+					typeName, err := c.columnType(table.Rel.Name, cmd.Def)
+					if err != nil {
+						return err
+					}
+					col.Type = typeName
+				}
+				table.Columns = append(table.Columns, col)
 
 			case ast.AT_AlterColumnType:
 				table.Columns[idx].Type = *cmd.Def.TypeName
 				table.Columns[idx].IsArray = cmd.Def.IsArray
+				table.Columns[idx].IsUnsigned = cmd.Def.TypeName.Unsigned
 
 			case ast.AT_DropColumn:
+				if !cmd.Modify {
+					table.dropKeys(table.Columns[idx].Name)
+				}
+				c.dropColumnType(table.Rel.Name, table.Columns[idx])
 				table.Columns = append(table.Columns[:idx], table.Columns[idx+1:]...)
 
 			case ast.AT_DropNotNull:
@@ -91,6 +113,16 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 			case ast.AT_SetNotNull:
 				table.Columns[idx].IsNotNull = true
 
+			case ast.AT_AddConstraint:
+				if err := c.addConstraint(table, cmd.Constraint); err != nil {
+					return err
+				}
+
+			case ast.AT_DropConstraint:
+				// CHECK constraints are not tracked, so an unknown name is
+				// not an error.
+				table.dropConstraint(*cmd.Name)
+
 			}
 		}
 	}
@@ -154,23 +186,31 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 			newCol := *col // make a copy, so changes to the ReferTable don't propagate
 			tbl.Columns = append(tbl.Columns, &newCol)
 		}
+		for _, idx := range original.Indexes {
+			newIdx := *idx
+			newIdx.Columns = append([]string(nil), idx.Columns...)
+			tbl.Indexes = append(tbl.Indexes, &newIdx)
+		}
+		tbl.syncKeys()
 	} else {
 		for _, col := range stmt.Cols {
 			tc := &Column{
-				Name:      col.Colname,
-				Type:      *col.TypeName,
-				IsNotNull: col.IsNotNull,
-				IsArray:   col.IsArray,
-				Comment:   col.Comment,
-				Length:    col.Length,
+				Name:       col.Colname,
+				Type:       *col.TypeName,
+				IsNotNull:  col.IsNotNull,
+				IsArray:    col.IsArray,
+				IsUnsigned: col.TypeName.Unsigned,
+				Comment:    col.Comment,
+				Length:     col.Length,
+
+				Default:         col.Default,
+				Generated:       col.Generated,
+				IsAutoIncrement: col.IsAutoIncrement,
 			}
 			if col.Vals != nil {
-				typeName := ast.TypeName{
-					Name: fmt.Sprintf("%s_%s", stmt.Name.Name, col.Colname),
-				}
 				util.Xiazeminlog(" col.IsNotNull CreateEnumStmt", col, false)
-				s := &ast.CreateEnumStmt{TypeName: &typeName, Vals: col.Vals, IsNotNull: col.IsNotNull}
-				if err := c.createEnum(s); err != nil {
+				typeName, err := c.columnType(stmt.Name.Name, col)
+				if err != nil {
 					return err
 				}
 				tc.Type = typeName
@@ -178,10 +218,44 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 			tbl.Columns = append(tbl.Columns, tc)
 		}
 	}
+	for _, con := range stmt.Constraints {
+		if err := c.addConstraint(&tbl, con); err != nil {
+			return err
+		}
+	}
 	schema.Tables = append(schema.Tables, &tbl)
 	return nil
 }
 
+// columnType registers the members of a MySQL ENUM or SET column as a type
+// named <table>_<column>.
+func (c *Catalog) columnType(table string, col *ast.ColumnDef) (ast.TypeName, error) {
+	typeName := ast.TypeName{
+		Name: fmt.Sprintf("%s_%s", table, col.Colname),
+	}
+	if col.TypeName.Name == "set" {
+		return typeName, c.createSet(&typeName, col.Vals, col.IsNotNull)
+	}
+	s := &ast.CreateEnumStmt{TypeName: &typeName, Vals: col.Vals, IsNotNull: col.IsNotNull}
+	if err := c.createEnum(s); err != nil {
+		return typeName, err
+	}
+	return typeName, nil
+}
+
+// dropColumnType removes the type registered by columnType, so that a column
+// modified in place can register its new members.
+func (c *Catalog) dropColumnType(table string, col *Column) {
+	name := fmt.Sprintf("%s_%s", table, col.Name)
+	if col.Type.Name != name {
+		return
+	}
+	c.dropType(&ast.DropTypeStmt{
+		IfExists: true,
+		Types:    []*ast.TypeName{{Name: name}},
+	})
+}
+
 func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 	for _, name := range stmt.Tables {
 		ns := name.Schema
@@ -224,6 +298,7 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 	if idx == -1 {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
+	tbl.renameKeys(stmt.Col.Name, *stmt.NewName)
 	tbl.Columns[idx].Name = *stmt.NewName
 	return nil
 }
@@ -237,6 +312,16 @@ func (c *Catalog) renameTable(stmt *ast.RenameTableStmt) error {
 		return sqlerr.RelationExists(*stmt.NewName)
 	}
 	if stmt.NewName != nil {
+		// Foreign keys follow the table they reference.
+		for _, schema := range c.Schemas {
+			for _, t := range schema.Tables {
+				for _, fk := range t.ForeignKeys {
+					if sameRel(fk.RefTable, tbl.Rel) {
+						fk.RefTable.Name = *stmt.NewName
+					}
+				}
+			}
+		}
 		tbl.Rel.Name = *stmt.NewName
 	}
 	return nil
@@ -38,6 +38,32 @@ func (c *Catalog) createEnum(stmt *ast.CreateEnumStmt) error {
 	return nil
 }
 
+func (c *Catalog) createSet(name *ast.TypeName, vals *ast.List, isNotNull bool) error {
+	ns := name.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	tbl := &ast.TableName{
+		Name: name.Name,
+	}
+	if _, _, err := schema.getTable(tbl); err == nil {
+		return sqlerr.RelationExists(tbl.Name)
+	}
+	if _, _, err := schema.getType(name); err == nil {
+		return sqlerr.TypeExists(tbl.Name)
+	}
+	schema.Types = append(schema.Types, &Set{
+		Name:      name.Name,
+		Vals:      stringSlice(vals),
+		IsNotNull: isNotNull,
+	})
+	return nil
+}
+
 func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
 	ns := stmt.TypeName.Schema
 	if ns == "" {
@@ -0,0 +1,85 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
+)
+
+// columnGenerator computes the output columns of a query. The catalog cannot
+// type a SELECT on its own, so views are resolved by the compiler.
+type columnGenerator interface {
+	OutputColumns(node ast.Node) ([]*Column, error)
+}
+
+func (c *Catalog) createView(stmt *ast.ViewStmt, colGen columnGenerator) error {
+	return c.createQueryRelation(stmt.View, stmt.Aliases, stmt.Query, true, stmt.Replace, false, colGen)
+}
+
+// createTableAs handles CREATE MATERIALIZED VIEW and CREATE TABLE AS, whose
+// columns are those of their query.
+func (c *Catalog) createTableAs(stmt *ast.CreateTableAsStmt, colGen columnGenerator) error {
+	if stmt.Into == nil {
+		return nil
+	}
+	return c.createQueryRelation(stmt.Into.Rel, stmt.Into.ColNames, stmt.Query, false, false, stmt.IfNotExists, colGen)
+}
+
+func (c *Catalog) createQueryRelation(rv *ast.RangeVar, aliases *ast.List, query ast.Node, view, replace, ifNotExists bool, colGen columnGenerator) error {
+	if colGen == nil {
+		return errors.New("create view requires a column generator")
+	}
+	rel := &ast.TableName{}
+	if rv.Schemaname != nil {
+		rel.Schema = *rv.Schemaname
+	}
+	if rv.Relname != nil {
+		rel.Name = *rv.Relname
+	}
+	ns := rel.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	existing, idx, err := schema.getTable(rel)
+	exists := err == nil
+	if exists && ifNotExists {
+		return nil
+	} else if exists && !replace {
+		return sqlerr.RelationExists(rel.Name)
+	} else if exists && !existing.IsView {
+		return &sqlerr.Error{
+			Code:     "42809",
+			Message:  fmt.Sprintf("%q is not a view", rel.Name),
+			Location: rv.Location,
+		}
+	}
+
+	cols, err := colGen.OutputColumns(query)
+	if err != nil {
+		return err
+	}
+	if aliases != nil && len(aliases.Items) > 0 {
+		if len(aliases.Items) > len(cols) {
+			return errors.New("view column list is longer than the query's columns")
+		}
+		for i, item := range aliases.Items {
+			if name, ok := item.(*ast.String); ok {
+				cols[i].Name = name.Str
+			}
+		}
+	}
+
+	tbl := &Table{Rel: rel, Columns: cols, IsView: view}
+	if exists {
+		schema.Tables[idx] = tbl
+		return nil
+	}
+	schema.Tables = append(schema.Tables, tbl)
+	return nil
+}
@@ -57,3 +57,88 @@ type Schema struct {
 func (i *InformationSchema) Schemata() []Schema {
 	return []Schema{}
 }
+
+type TableConstraint struct {
+	Catalog    string
+	Schema     string
+	Table      string
+	Name       string
+	Type       string // PRIMARY KEY, UNIQUE or FOREIGN KEY
+	Columns    []string
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+}
+
+// SELECT * FROM information_schema.table_constraints;
+func (i *InformationSchema) TableConstraints() []TableConstraint {
+	var cons []TableConstraint
+	for _, s := range i.c.Schemas {
+		for _, t := range s.Tables {
+			for _, idx := range t.Indexes {
+				if !idx.IsUnique {
+					continue
+				}
+				con := TableConstraint{
+					Catalog: i.c.Name,
+					Schema:  s.Name,
+					Table:   t.Rel.Name,
+					Name:    idx.Name,
+					Type:    "UNIQUE",
+					Columns: idx.Columns,
+				}
+				if idx.IsPrimary {
+					con.Type = "PRIMARY KEY"
+				}
+				cons = append(cons, con)
+			}
+			for _, fk := range t.ForeignKeys {
+				refSchema := fk.RefTable.Schema
+				if refSchema == "" {
+					refSchema = i.c.DefaultSchema
+				}
+				cons = append(cons, TableConstraint{
+					Catalog:    i.c.Name,
+					Schema:     s.Name,
+					Table:      t.Rel.Name,
+					Name:       fk.Name,
+					Type:       "FOREIGN KEY",
+					Columns:    fk.Columns,
+					RefSchema:  refSchema,
+					RefTable:   fk.RefTable.Name,
+					RefColumns: fk.RefColumns,
+				})
+			}
+		}
+	}
+	return cons
+}
+
+type Index struct {
+	Catalog  string
+	Schema   string
+	Table    string
+	Name     string
+	Columns  []string
+	IsUnique bool
+}
+
+// SELECT * FROM pg_indexes; or SELECT * FROM information_schema.statistics;
+func (i *InformationSchema) Indexes() []Index {
+	var indexes []Index
+	for _, s := range i.c.Schemas {
+		for _, t := range s.Tables {
+			for _, idx := range t.Indexes {
+				indexes = append(indexes, Index{
+					Catalog:  i.c.Name,
+					Schema:   s.Name,
+					Table:    t.Rel.Name,
+					Name:     idx.Name,
+					Columns:  idx.Columns,
+					IsUnique: idx.IsUnique,
+				})
+			}
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,112 @@
+package info
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xiazemin/sqlc/internal/engine/dolphin"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestKeys(t *testing.T) {
+	p := dolphin.NewParser()
+
+	for i, tc := range []struct {
+		stmt        string
+		constraints []TableConstraint
+		indexes     []Index
+	}{
+		{
+			`
+			CREATE TABLE orgs (id bigint NOT NULL PRIMARY KEY);
+			CREATE TABLE users (
+				id     bigint NOT NULL PRIMARY KEY,
+				org_id bigint NOT NULL,
+				email  varchar(255) NOT NULL,
+				name   text NOT NULL,
+				UNIQUE KEY (email),
+				KEY name_idx (name(10)),
+				FOREIGN KEY (org_id) REFERENCES orgs (id)
+			);
+			`,
+			[]TableConstraint{
+				{Schema: "public", Table: "orgs", Name: "PRIMARY", Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{Schema: "public", Table: "users", Name: "email", Type: "UNIQUE", Columns: []string{"email"}},
+				{Schema: "public", Table: "users", Name: "PRIMARY", Type: "PRIMARY KEY", Columns: []string{"id"}},
+				{
+					Schema: "public", Table: "users", Name: "users_ibfk_1", Type: "FOREIGN KEY", Columns: []string{"org_id"},
+					RefSchema: "public", RefTable: "orgs", RefColumns: []string{"id"},
+				},
+			},
+			[]Index{
+				{Schema: "public", Table: "orgs", Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true},
+				{Schema: "public", Table: "users", Name: "email", Columns: []string{"email"}, IsUnique: true},
+				{Schema: "public", Table: "users", Name: "name_idx", Columns: []string{"name"}},
+				{Schema: "public", Table: "users", Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true},
+			},
+		},
+		{
+			`
+			CREATE TABLE users (
+				id    int NOT NULL PRIMARY KEY,
+				email varchar(255) NOT NULL,
+				UNIQUE KEY (email)
+			);
+			ALTER TABLE users MODIFY COLUMN id bigint NOT NULL;
+			ALTER TABLE users MODIFY COLUMN email varchar(320) NOT NULL;
+			`,
+			[]TableConstraint{
+				{Schema: "public", Table: "users", Name: "email", Type: "UNIQUE", Columns: []string{"email"}},
+				{Schema: "public", Table: "users", Name: "PRIMARY", Type: "PRIMARY KEY", Columns: []string{"id"}},
+			},
+			[]Index{
+				{Schema: "public", Table: "users", Name: "email", Columns: []string{"email"}, IsUnique: true},
+				{Schema: "public", Table: "users", Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true},
+			},
+		},
+		{
+			`
+			CREATE TABLE users (
+				id    bigint NOT NULL PRIMARY KEY,
+				email varchar(255) NOT NULL,
+				UNIQUE KEY (email)
+			);
+			ALTER TABLE users DROP COLUMN email;
+			`,
+			[]TableConstraint{
+				{Schema: "public", Table: "users", Name: "PRIMARY", Type: "PRIMARY KEY", Columns: []string{"id"}},
+			},
+			[]Index{
+				{Schema: "public", Table: "users", Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true},
+			},
+		},
+	} {
+		test := tc
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(test.stmt))
+			if err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			c := dolphin.NewCatalog()
+			if err := c.Build(stmts); err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			i := Newo(c)
+			if diff := cmp.Diff(test.constraints, i.TableConstraints(), cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("table constraints mismatch:\n%s", diff)
+			}
+			if diff := cmp.Diff(test.indexes, i.Indexes(), cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("indexes mismatch:\n%s", diff)
+			}
+		})
+	}
+}
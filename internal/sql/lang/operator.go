@@ -11,6 +11,13 @@ func IsComparisonOperator(s string) bool {
 	case "=":
 	case "<>":
 	case "!=":
+	case "~~":
+	case "!~~":
+	case "~~*":
+	case "!~~*":
+	case "~*":
+	case "!~":
+	case "!~*":
 	default:
 		return false
 	}
@@ -5,6 +5,22 @@ import (
 	"github.com/xiazemin/sqlc/internal/sql/astutils"
 )
 
+// Nullability controls the nullability of a named parameter. By default a
+// parameter inherits the nullability of the column it is compared against.
+type Nullability int
+
+const (
+	NullUnspecified Nullability = iota
+	Nullable
+	NotNull
+)
+
+// Param describes a named parameter found by rewrite.NamedParameters
+type Param struct {
+	Name        string
+	Nullability Nullability
+}
+
 func IsParamFunc(node ast.Node) bool {
 	call, ok := node.(*ast.FuncCall)
 	if !ok {
@@ -13,7 +29,27 @@ func IsParamFunc(node ast.Node) bool {
 	if call.Func == nil {
 		return false
 	}
-	return call.Func.Schema == "sqlc" && call.Func.Name == "arg"
+	if call.Func.Schema != "sqlc" {
+		return false
+	}
+	switch call.Func.Name {
+	case "arg", "narg":
+		return true
+	default:
+		return false
+	}
+}
+
+// ParamFuncNullability returns the nullability forced by a parameter function:
+// sqlc.narg is always nullable and sqlc.arg inherits the nullability of the
+// column unless it is followed by ::not null
+func ParamFuncNullability(call *ast.FuncCall) Nullability {
+	switch call.Func.Name {
+	case "narg":
+		return Nullable
+	default:
+		return NullUnspecified
+	}
 }
 
 func IsParamSign(node ast.Node) bool {
@@ -21,8 +57,8 @@ func IsParamSign(node ast.Node) bool {
 	return ok && astutils.Join(expr.Name, ".") == "@"
 }
 
-func IsIn(node ast.Node)bool{
-	if _,ok:=node.(*ast.In);ok{
+func IsIn(node ast.Node) bool {
+	if _, ok := node.(*ast.In); ok {
 		return true
 	}
 	return false
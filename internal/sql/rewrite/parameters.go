@@ -2,6 +2,7 @@ package rewrite
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/xiazemin/sqlc/internal/config"
 	"github.com/xiazemin/sqlc/internal/source"
@@ -41,16 +42,27 @@ func isNamedParamSignCast(node ast.Node) bool {
 	return astutils.Join(expr.Name, ".") == "@" && cast
 }
 
-func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[int]string, []source.Edit) {
+// NamedParameters replaces named parameters with numbered ones. notNull maps
+// the location of a sqlc.arg call to the length of the blanked ::not null that
+// followed it in the source.
+func NamedParameters(engine config.Engine, raw *ast.RawStmt, notNull map[int]int) (*ast.RawStmt, map[int]named.Param, []source.Edit) {
 	foundFunc := astutils.Search(raw, named.IsParamFunc)
 	foundSign := astutils.Search(raw, named.IsParamSign)
 	if len(foundFunc.Items)+len(foundSign.Items) == 0 {
-		return raw, map[int]string{}, nil
+		return raw, map[int]named.Param{}, nil
 	}
 
-	hasNamedParameterSupport := engine != config.EngineMySQL
+	// MySQL has no numbered placeholders, so every occurrence of a named
+	// parameter becomes a "?" while still sharing a single parameter number.
+	placeholder := func(num int) string {
+		if engine == config.EngineMySQL {
+			return "?"
+		}
+		return fmt.Sprintf("$%d", num)
+	}
 
 	args := map[string]int{}
+	nullability := map[int]named.Nullability{}
 	argn := 0
 	var edits []source.Edit
 	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
@@ -60,7 +72,7 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 		case named.IsParamFunc(node): //函数参数
 			fun := node.(*ast.FuncCall)
 			param, isConst := flatten(fun.Args)
-			if num, ok := args[param]; ok && hasNamedParameterSupport {
+			if num, ok := args[param]; ok {
 				cr.Replace(&ast.ParamRef{
 					Number:   num,
 					Location: fun.Location,
@@ -73,22 +85,24 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 					Location: fun.Location,
 				})
 			}
+			if n := named.ParamFuncNullability(fun); n != named.NullUnspecified {
+				nullability[args[param]] = n
+			}
 			// TODO: This code assumes that sqlc.arg(name) is on a single line
-			var old, replace string
+			var old string
 			if isConst {
-				old = fmt.Sprintf("sqlc.arg('%s')", param)
+				old = fmt.Sprintf("sqlc.%s('%s')", fun.Func.Name, param)
 			} else {
-				old = fmt.Sprintf("sqlc.arg(%s)", param)
+				old = fmt.Sprintf("sqlc.%s(%s)", fun.Func.Name, param)
 			}
-			if engine == config.EngineMySQL {
-				replace = "?"
-			} else {
-				replace = fmt.Sprintf("$%d", args[param])
+			if n, ok := notNull[fun.Location]; ok {
+				nullability[args[param]] = named.NotNull
+				old += strings.Repeat(" ", n)
 			}
 			edits = append(edits, source.Edit{
 				Location: fun.Location - raw.StmtLocation,
 				Old:      old,
-				New:      replace,
+				New:      placeholder(args[param]),
 			})
 			return false
 
@@ -115,7 +129,7 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 			edits = append(edits, source.Edit{
 				Location: expr.Location - raw.StmtLocation,
 				Old:      fmt.Sprintf("@%s", param),
-				New:      fmt.Sprintf("$%d", args[param]),
+				New:      placeholder(args[param]),
 			})
 			return false
 
@@ -139,7 +153,7 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 			edits = append(edits, source.Edit{
 				Location: expr.Location - raw.StmtLocation,
 				Old:      fmt.Sprintf("@%s", param),
-				New:      fmt.Sprintf("$%d", args[param]),
+				New:      placeholder(args[param]),
 			})
 			return false
 		case named.IsIn(node):
@@ -149,9 +163,9 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 		}
 	}, nil)
 
-	named := map[int]string{}
+	params := map[int]named.Param{}
 	for k, v := range args {
-		named[v] = k
+		params[v] = named.Param{Name: k, Nullability: nullability[v]}
 	}
-	return node.(*ast.RawStmt), named, edits
+	return node.(*ast.RawStmt), params, edits
 }
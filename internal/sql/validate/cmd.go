@@ -8,7 +8,13 @@ import (
 
 func Cmd(n ast.Node, name, cmd string) error {
 	// TODO: Convert cmd to an enum
-	if !(cmd == ":many" || cmd == ":one") {
+	if cmd == ":paginate" || cmd == ":nested" {
+		if _, ok := n.(*ast.SelectStmt); !ok {
+			return fmt.Errorf("query %q specifies parameter %q but is not a SELECT statement", name, cmd)
+		}
+		return nil
+	}
+	if !(cmd == ":many" || cmd == ":one" || cmd == ":iter") {
 		return nil
 	}
 	var list *ast.List
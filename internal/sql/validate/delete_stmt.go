@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
+)
+
+// DeleteStmt checks that every target of a multi-table DELETE names a table,
+// or the alias of a table, it reads from.
+func DeleteStmt(stmt *ast.DeleteStmt) error {
+	if stmt.Targets == nil {
+		return nil
+	}
+	tables := []ast.Node{stmt.Relation}
+	if stmt.UsingClause != nil {
+		tables = append(tables, stmt.UsingClause.Items...)
+	}
+	for _, item := range stmt.Targets.Items {
+		target, ok := item.(*ast.RangeVar)
+		if !ok || target.Relname == nil {
+			continue
+		}
+		if !deletesFrom(tables, *target.Relname) {
+			err := sqlerr.RelationNotFound(*target.Relname)
+			err.Location = target.Location
+			return err
+		}
+	}
+	return nil
+}
+
+func deletesFrom(tables []ast.Node, name string) bool {
+	for _, table := range tables {
+		rv, ok := table.(*ast.RangeVar)
+		if !ok || rv.Relname == nil {
+			continue
+		}
+		if rv.Alias != nil && rv.Alias.Aliasname != nil {
+			if *rv.Alias.Aliasname == name {
+				return true
+			}
+			continue
+		}
+		if *rv.Relname == name {
+			return true
+		}
+	}
+	return false
+}
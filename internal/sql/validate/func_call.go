@@ -7,6 +7,7 @@ import (
 	"github.com/xiazemin/sqlc/internal/sql/ast"
 	"github.com/xiazemin/sqlc/internal/sql/astutils"
 	"github.com/xiazemin/sqlc/internal/sql/catalog"
+	"github.com/xiazemin/sqlc/internal/sql/named"
 	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
 )
 
@@ -32,7 +33,19 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 	// Custom validation for sqlc.arg
 	// TODO: Replace this once type-checking is implemented
 	if fn.Schema == "sqlc" {
-		if fn.Name != "arg" {
+		// sqlc.order_by is resolved by the compiler
+		if fn.Name == "order_by" {
+			return nil
+		}
+		// sqlc.embed is expanded by the compiler
+		if fn.Name == "embed" {
+			return nil
+		}
+		// sqlc.optional wraps a predicate that is validated like any other
+		if fn.Name == "optional" {
+			return v
+		}
+		if !named.IsParamFunc(call) {
 			v.err = sqlerr.FunctionNotFound("sqlc." + fn.Name)
 			return nil
 		}
@@ -41,7 +54,7 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 		}
 		if len(call.Args.Items) > 1 {
 			v.err = &sqlerr.Error{
-				Message:  fmt.Sprintf("expected 1 parameter to sqlc.arg; got %d", len(call.Args.Items)),
+				Message:  fmt.Sprintf("expected 1 parameter to sqlc.%s; got %d", fn.Name, len(call.Args.Items)),
 				Location: call.Pos(),
 			}
 			return nil
@@ -51,7 +64,7 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 		case *ast.ColumnRef:
 		default:
 			v.err = &sqlerr.Error{
-				Message:  fmt.Sprintf("expected parameter to sqlc.arg to be string or reference; got %T", n),
+				Message:  fmt.Sprintf("expected parameter to sqlc.%s to be string or reference; got %T", fn.Name, n),
 				Location: call.Pos(),
 			}
 			return nil
@@ -1,7 +1,10 @@
 package validate
 
 import (
+	"fmt"
+
 	"github.com/xiazemin/sqlc/internal/sql/ast"
+	"github.com/xiazemin/sqlc/internal/sql/catalog"
 	"github.com/xiazemin/sqlc/internal/sql/sqlerr"
 )
 
@@ -53,3 +56,55 @@ func checkList(sublist *ast.List, colsLen int) error {
 	}
 	return nil
 }
+
+// GeneratedColumns rejects inserts that set a generated column to anything
+// but DEFAULT.
+func GeneratedColumns(c *catalog.Catalog, stmt *ast.InsertStmt) error {
+	if stmt.Relation == nil || stmt.Relation.Relname == nil || stmt.Cols == nil {
+		return nil
+	}
+	rel := &ast.TableName{Name: *stmt.Relation.Relname}
+	if stmt.Relation.Schemaname != nil {
+		rel.Schema = *stmt.Relation.Schemaname
+	}
+	table, err := c.GetTable(rel)
+	if err != nil {
+		return nil
+	}
+	generated := map[string]bool{}
+	for _, col := range table.Columns {
+		if col.Generated != "" {
+			generated[col.Name] = true
+		}
+	}
+	for i, item := range stmt.Cols.Items {
+		res, ok := item.(*ast.ResTarget)
+		if !ok || res.Name == nil || !generated[*res.Name] || allDefault(stmt.SelectStmt, i) {
+			continue
+		}
+		return &sqlerr.Error{
+			Code:     "42601",
+			Message:  fmt.Sprintf("cannot insert into generated column %q of table %q", *res.Name, rel.Name),
+			Location: res.Location,
+		}
+	}
+	return nil
+}
+
+// allDefault reports whether every VALUES row sets column i to DEFAULT.
+func allDefault(node ast.Node, i int) bool {
+	sel, ok := node.(*ast.SelectStmt)
+	if !ok || sel.ValuesLists == nil {
+		return false
+	}
+	for _, item := range sel.ValuesLists.Items {
+		row, ok := item.(*ast.List)
+		if !ok || i >= len(row.Items) {
+			return false
+		}
+		if _, ok := row.Items[i].(*ast.SetToDefault); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -24,7 +24,8 @@ SELECT p.proname as name,
   format_type(p.prorettype, NULL),
   array(select format_type(unnest(p.proargtypes), NULL)),
   p.proargnames,
-  p.proargnames[p.pronargs-p.pronargdefaults+1:p.pronargs]
+  p.proargnames[p.pronargs-p.pronargdefaults+1:p.pronargs],
+  p.prokind = 'a'
 FROM pg_catalog.pg_proc p
 LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
 WHERE n.nspname OPERATOR(pg_catalog.~) '^(pg_catalog)$'
@@ -50,7 +51,8 @@ SELECT p.proname as name,
   format_type(p.prorettype, NULL),
   array(select format_type(unnest(p.proargtypes), NULL)),
   p.proargnames,
-  p.proargnames[p.pronargs-p.pronargdefaults+1:p.pronargs]
+  p.proargnames[p.pronargs-p.pronargdefaults+1:p.pronargs],
+  p.prokind = 'a'
 FROM pg_catalog.pg_proc p
 JOIN extension_funcs ef ON ef.oid = p.oid
 WHERE p.proargmodes IS NULL
@@ -87,6 +89,9 @@ func {{.Name}}() *catalog.Schema {
 				{{end}}
 			},
 			ReturnType: &ast.TypeName{Name: "{{.ReturnType.Name}}"},
+			{{- if .ReturnNullability}}
+			ReturnNullability: catalog.{{.ReturnNullability}},
+			{{- end}}
 		},
 		{{- end}}
 	}
@@ -133,6 +138,7 @@ type Proc struct {
 	ArgTypes   []string
 	ArgNames   []string
 	HasDefault []string
+	Aggregate  bool
 }
 
 func clean(arg string) string {
@@ -143,12 +149,25 @@ func clean(arg string) string {
 	return arg
 }
 
+// Aggregates return NULL over no rows, except for those that count them.
+var neverNull = map[string]bool{
+	"count":      true,
+	"regr_count": true,
+}
+
 func (p Proc) Func() catalog.Function {
-	return catalog.Function{
+	fn := catalog.Function{
 		Name:       p.Name,
 		Args:       p.Args(),
 		ReturnType: &ast.TypeName{Name: clean(p.ReturnType)},
 	}
+	switch {
+	case neverNull[p.Name]:
+		fn.ReturnNullability = catalog.NeverNull
+	case p.Aggregate:
+		fn.ReturnNullability = catalog.AlwaysNullable
+	}
+	return fn
 }
 
 func (p Proc) Args() []*catalog.Argument {
@@ -186,6 +205,7 @@ func scanFuncs(rows pgx.Rows) ([]catalog.Function, error) {
 			&p.ArgTypes,
 			&p.ArgNames,
 			&p.HasDefault,
+			&p.Aggregate,
 		)
 		if err != nil {
 			return nil, err